@@ -0,0 +1,115 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst"
+)
+
+const exportCommandName = "export"
+
+const exportUsage = `Usage: terraform-provider-spotinst export --resource TYPE --id ID [--id ID...] [options]
+
+  Reads existing Spotinst objects and prints matching Terraform configuration,
+  followed by the terraform import commands needed to adopt them.
+
+Options:
+`
+
+// idList collects repeated (or comma separated) --id flags.
+type idList []string
+
+func (l *idList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *idList) Set(value string) error {
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			*l = append(*l, id)
+		}
+	}
+	return nil
+}
+
+// runExport implements the export command and returns the process exit code.
+func runExport(args []string, stdout, stderr io.Writer) int {
+	var (
+		ids          idList
+		resourceType string
+		token        string
+		account      string
+		baseURL      string
+		importScript string
+	)
+
+	flags := flag.NewFlagSet(exportCommandName, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, exportUsage)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&resourceType, "resource", "", "Resource type to export, e.g. spotinst_elastigroup_aws")
+	flags.Var(&ids, "id", "ID or name of the object to export (repeatable, or comma separated)")
+	flags.StringVar(&token, "token", os.Getenv(credentials.EnvCredentialsVarToken), "Spotinst Personal API Access Token")
+	flags.StringVar(&account, "account", os.Getenv(credentials.EnvCredentialsVarAccount), "Spotinst Account ID")
+	flags.StringVar(&baseURL, "base-url", "", "Override the Spotinst API endpoint")
+	flags.StringVar(&importScript, "import-script", "", "Write the terraform import commands to this file instead of appending them as comments")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if resourceType == "" || len(ids) == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	// Keep the SDK request logging out of the generated configuration unless asked for.
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	config := spotinst.Config{
+		Token:   token,
+		Account: account,
+		BaseURL: baseURL,
+	}
+	client, err := config.Client()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	exporter := spotinst.NewExporter(client)
+	var imports []string
+	for _, id := range ids {
+		exported, err := exporter.Export(resourceType, id)
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to export %s [%s]: %v\n", resourceType, id, err)
+			return 1
+		}
+		fmt.Fprintln(stdout, exported.HCL)
+		imports = append(imports, exported.ImportCommand())
+	}
+
+	if importScript != "" {
+		script := "#!/bin/sh\nset -e\n\n" + strings.Join(imports, "\n") + "\n"
+		if err := ioutil.WriteFile(importScript, []byte(script), 0755); err != nil {
+			fmt.Fprintf(stderr, "Failed to write import script: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	for _, cmd := range imports {
+		fmt.Fprintf(stdout, "# %s\n", cmd)
+	}
+	return 0
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == exportCommandName {
+		os.Exit(runExport(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: spotinst.Provider})
 }
@@ -0,0 +1,73 @@
+package spotinst
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestElastigroupAzureRollWaitAndStop(t *testing.T) {
+	cases := []struct {
+		progress  int
+		stop      bool
+		expectErr bool
+	}{
+		{progress: 100, stop: true, expectErr: false},
+		{progress: 10, stop: false, expectErr: true},
+		{progress: 10, stop: true, expectErr: true},
+	}
+
+	for _, c := range cases {
+		api := newTestOfflineAPI(t)
+
+		group := `{"id": "sig-azure123", "name": "azure-roll", "capacity": {"minimum": 0, "maximum": 3, "target": 1}}`
+		api.Handle("PUT", "/compute/azure/group/sig-azure123", group)
+		api.Handle("GET", "/compute/azure/group/sig-azure123", group)
+		api.Handle("PUT", "/compute/azure/group/sig-azure123/roll", `{"id": "sbgd-1234", "status": "STARTING", "progress": {"unit": "percent", "value": 0}}`)
+		api.Handle("GET", "/compute/azure/group/sig-azure123/roll/sbgd-1234", fmt.Sprintf(`{"id": "sbgd-1234", "status": "IN_PROGRESS", "progress": {"unit": "percent", "value": %d}}`, c.progress))
+		api.Handle("PUT", "/compute/azure/group/sig-azure123/roll/sbgd-1234")
+
+		res := resourceSpotinstElastigroupAzure()
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":             "azure-roll",
+			"desired_capacity": 2,
+			"update_policy": []interface{}{
+				map[string]interface{}{
+					"should_roll": true,
+					"roll_config": []interface{}{
+						map[string]interface{}{
+							"batch_size_percentage":    50,
+							"wait_for_roll_percentage": 50,
+							"wait_for_roll_timeout":    1,
+							"stop_roll_on_timeout":     c.stop,
+						},
+					},
+				},
+			},
+		})
+		resourceData.SetId("sig-azure123")
+
+		err := res.Update(resourceData, api.Client(t))
+		if c.expectErr != (err != nil) {
+			t.Fatalf("progress %d: expected error=%v, got %v", c.progress, c.expectErr, err)
+		}
+
+		stopped := false
+		for _, r := range api.Requests() {
+			if r == "PUT /compute/azure/group/sig-azure123/roll/sbgd-1234" {
+				stopped = true
+			}
+		}
+		if expected := c.expectErr && c.stop; stopped != expected {
+			t.Fatalf("progress %d, stop %v: expected stopped=%v, got %v", c.progress, c.stop, expected, stopped)
+		}
+		if stopped {
+			if body := api.RequestBody("PUT", "/compute/azure/group/sig-azure123/roll/sbgd-1234"); !strings.Contains(body, `"status":"STOPPED"`) {
+				t.Fatalf("expected the stop request to set the STOPPED status, got %s", body)
+			}
+		}
+		api.Close()
+	}
+}
@@ -0,0 +1,62 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestElastigroupAzureCreateStrategyAndSignals(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+
+	group := `{"id": "sig-azure123", "name": "azure-rancher", "capacity": {"minimum": 0, "maximum": 1, "target": 0}, "strategy": {"lowPriorityPercentage": 80, "signals": [{"name": "vmReady", "timeout": 600}]}, "thirdPartiesIntegration": {"rancher": {"masterHost": "https://rancher.example.com", "accessKey": "access", "secretKey": "secret"}}}`
+	api.Handle("POST", "/compute/azure/group", group)
+	api.Handle("GET", "/compute/azure/group/sig-azure123", group)
+
+	res := resourceSpotinstElastigroupAzure()
+
+	// Strategy and signal share the same API object, repeat the create so
+	// both orders of the fields map are exercised.
+	for i := 0; i < 10; i++ {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name": "azure-rancher",
+			"strategy": []interface{}{
+				map[string]interface{}{"low_priority_percentage": 80},
+			},
+			"signal": []interface{}{
+				map[string]interface{}{"name": "vmReady", "timeout": 600},
+			},
+			"integration_rancher": []interface{}{
+				map[string]interface{}{
+					"master_host": "https://rancher.example.com",
+					"access_key":  "access",
+					"secret_key":  "secret",
+				},
+			},
+		})
+
+		if err := res.Create(resourceData, api.Client(t)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		body := api.RequestBody("POST", "/compute/azure/group")
+		for _, expected := range []string{
+			`"lowPriorityPercentage":80`,
+			`"signals":[{"name":"vmReady","timeout":600}]`,
+			`"rancher":{"masterHost":"https://rancher.example.com","accessKey":"access","secretKey":"secret"}`,
+		} {
+			if !strings.Contains(body, expected) {
+				t.Fatalf("expected the create request to contain %s, got %s", expected, body)
+			}
+		}
+
+		if got := resourceData.Get("signal").(*schema.Set).Len(); got != 1 {
+			t.Fatalf("expected 1 signal, got %d", got)
+		}
+		if got := resourceData.Get("integration_rancher.0.master_host").(string); got != "https://rancher.example.com" {
+			t.Fatalf("expected the Rancher master host to be read, got %q", got)
+		}
+	}
+}
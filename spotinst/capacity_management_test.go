@@ -0,0 +1,157 @@
+package spotinst
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+// testCapacityResource wires a resource with capacity fields to the offline API,
+// with the capacity the API returns: min 1, max 4 and target 2.
+type testCapacityResource struct {
+	name     string
+	id       string
+	path     string
+	body     string
+	resource func() *schema.Resource
+
+	// update returns the min, max and target sent by an update.
+	update func(*schema.ResourceData, interface{}) (*int, *int, *int, error)
+}
+
+var testCapacityResources = []testCapacityResource{
+	{
+		name:     "elastigroup_aws",
+		id:       "sig-12345678",
+		path:     "/aws/ec2/group/sig-12345678",
+		body:     testExportElastigroupAWS,
+		resource: resourceSpotinstElastigroupAws,
+		update: func(resourceData *schema.ResourceData, meta interface{}) (*int, *int, *int, error) {
+			_, group, err := commons.ElastigroupResource.OnUpdate(resourceData, meta)
+			if err != nil || group.Capacity == nil {
+				return nil, nil, nil, err
+			}
+			return group.Capacity.Minimum, group.Capacity.Maximum, group.Capacity.Target, nil
+		},
+	},
+	{
+		name:     "elastigroup_gcp",
+		id:       "sig-12345678",
+		path:     "/gcp/gce/group/sig-12345678",
+		body:     `{"id": "sig-12345678", "name": "web servers", "capacity": {"minimum": 1, "maximum": 4, "target": 2}}`,
+		resource: resourceSpotinstElastigroupGCP,
+		update: func(resourceData *schema.ResourceData, meta interface{}) (*int, *int, *int, error) {
+			_, group, err := commons.ElastigroupGCPResource.OnUpdate(resourceData, meta)
+			if err != nil || group.Capacity == nil {
+				return nil, nil, nil, err
+			}
+			return group.Capacity.Minimum, group.Capacity.Maximum, group.Capacity.Target, nil
+		},
+	},
+	{
+		name:     "elastigroup_azure",
+		id:       "sig-12345678",
+		path:     "/compute/azure/group/sig-12345678",
+		body:     `{"id": "sig-12345678", "name": "web servers", "capacity": {"minimum": 1, "maximum": 4, "target": 2}}`,
+		resource: resourceSpotinstElastigroupAzure,
+		update: func(resourceData *schema.ResourceData, meta interface{}) (*int, *int, *int, error) {
+			_, group, err := commons.ElastigroupAzureResource.OnUpdate(resourceData, meta)
+			if err != nil || group.Capacity == nil {
+				return nil, nil, nil, err
+			}
+			return group.Capacity.Minimum, group.Capacity.Maximum, group.Capacity.Target, nil
+		},
+	},
+	{
+		name:     "ocean_aws",
+		id:       "o-12345678",
+		path:     "/ocean/aws/k8s/cluster/o-12345678",
+		body:     `{"id": "o-12345678", "name": "k8s", "capacity": {"minimum": 1, "maximum": 4, "target": 2}}`,
+		resource: resourceSpotinstOceanAWS,
+		update: func(resourceData *schema.ResourceData, meta interface{}) (*int, *int, *int, error) {
+			_, cluster, err := commons.OceanResource.OnUpdate(resourceData, meta)
+			if err != nil || cluster.Capacity == nil {
+				return nil, nil, nil, err
+			}
+			return cluster.Capacity.Minimum, cluster.Capacity.Maximum, cluster.Capacity.Target, nil
+		},
+	},
+}
+
+func TestCapacityManagementRead(t *testing.T) {
+	// The state holds min 0, max 6 and target 3 from the last apply.
+	cases := []struct {
+		mode     string
+		desired  int
+		minSize  int
+		maxSize  int
+		imported bool
+	}{
+		{mode: "full", desired: 2, minSize: 1, maxSize: 4},
+		{mode: "min_max", desired: 3, minSize: 1, maxSize: 4},
+		{mode: "create_only", desired: 3, minSize: 0, maxSize: 6},
+		{mode: "create_only", desired: 2, minSize: 1, maxSize: 4, imported: true},
+	}
+
+	for _, r := range testCapacityResources {
+		api := newTestOfflineAPI(t)
+		api.Handle("GET", r.path, r.body)
+
+		for _, c := range cases {
+			attributes := map[string]string{
+				"id":                  r.id,
+				"capacity_management": c.mode,
+			}
+			if !c.imported {
+				attributes["desired_capacity"] = "3"
+				attributes["min_size"] = "0"
+				attributes["max_size"] = "6"
+			}
+
+			res := r.resource()
+			resourceData := res.Data(&terraform.InstanceState{ID: r.id, Attributes: attributes})
+			if err := res.Read(resourceData, api.Client(t)); err != nil {
+				t.Fatalf("%s/%s: unexpected error: %v", r.name, c.mode, err)
+			}
+
+			if got := resourceData.Get("desired_capacity").(int); got != c.desired {
+				t.Fatalf("%s/%s: expected desired_capacity %d, got %d", r.name, c.mode, c.desired, got)
+			}
+			if got := resourceData.Get("min_size").(int); got != c.minSize {
+				t.Fatalf("%s/%s: expected min_size %d, got %d", r.name, c.mode, c.minSize, got)
+			}
+			if got := resourceData.Get("max_size").(int); got != c.maxSize {
+				t.Fatalf("%s/%s: expected max_size %d, got %d", r.name, c.mode, c.maxSize, got)
+			}
+		}
+		api.Close()
+	}
+}
+
+func TestCapacityManagementUpdate(t *testing.T) {
+	// Capacity edited in configuration is sent whatever the mode, only
+	// changes made outside Terraform are ignored.
+	for _, r := range testCapacityResources {
+		for _, mode := range []string{"full", "min_max", "create_only"} {
+			res := r.resource()
+			resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+				"capacity_management": mode,
+				"desired_capacity":    5,
+				"min_size":            2,
+				"max_size":            10,
+			})
+
+			minSize, maxSize, desired, err := r.update(resourceData, nil)
+			if err != nil {
+				t.Fatalf("%s/%s: unexpected error: %v", r.name, mode, err)
+			}
+			if spotinst.IntValue(desired) != 5 || spotinst.IntValue(minSize) != 2 || spotinst.IntValue(maxSize) != 10 {
+				t.Fatalf("%s/%s: expected min 2, max 10 and target 5 to be sent, got min %v, max %v and target %v",
+					r.name, mode, spotinst.IntValue(minSize), spotinst.IntValue(maxSize), spotinst.IntValue(desired))
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package commons
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+type CapacityManagementMode string
+
+const (
+	// CapacityManagementFull refreshes min, max and desired capacity from the API.
+	CapacityManagementFull CapacityManagementMode = "full"
+
+	// CapacityManagementMinMax ignores changes to the desired capacity made outside
+	// Terraform, min and max are still refreshed.
+	CapacityManagementMinMax CapacityManagementMode = "min_max"
+
+	// CapacityManagementCreateOnly ignores changes to min, max and desired capacity
+	// made outside Terraform.
+	CapacityManagementCreateOnly CapacityManagementMode = "create_only"
+
+	CapacityManagement FieldName = "capacity_management"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//          Constructors
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// NewCapacityManagementField returns the field selecting which capacity fields are
+// refreshed from the API. Edits to a capacity field in configuration are always applied.
+// The field is only used by the capacity fields themselves.
+func NewCapacityManagementField(resourceAffinity ResourceAffinity) *GenericField {
+	return NewGenericField(
+		resourceAffinity,
+		CapacityManagement,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(CapacityManagementFull),
+			ValidateFunc: validateCapacityManagementMode,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//   Methods: CapacityManagementMode
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// GetCapacityManagementMode returns the mode configured for the resource.
+// Resources imported or created before the field existed are fully managed.
+func GetCapacityManagementMode(resourceData *schema.ResourceData) CapacityManagementMode {
+	if v, ok := resourceData.Get(string(CapacityManagement)).(string); ok && v != "" {
+		return CapacityManagementMode(v)
+	}
+	return CapacityManagementFull
+}
+
+// ManagesDesiredCapacity reports whether the desired capacity is refreshed from the API.
+func (mode CapacityManagementMode) ManagesDesiredCapacity() bool {
+	return mode == CapacityManagementFull
+}
+
+// ManagesCapacityLimits reports whether min and max capacity are refreshed from the API.
+func (mode CapacityManagementMode) ManagesCapacityLimits() bool {
+	return mode != CapacityManagementCreateOnly
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// ShouldReadCapacity reports whether the value returned by the API should be stored
+// in state. Unmanaged capacity fields keep the value already in state, so changes made
+// outside Terraform (e.g. by an autoscaler) do not show up in plan. A field without a
+// value in state (e.g. right after import) is always read.
+func ShouldReadCapacity(resourceData *schema.ResourceData, fieldName FieldName, managed bool) bool {
+	if managed {
+		return true
+	}
+	_, exists := resourceData.GetOkExists(string(fieldName))
+	return !exists
+}
+
+func validateCapacityManagementMode(v interface{}, k string) ([]string, []error) {
+	switch CapacityManagementMode(v.(string)) {
+	case CapacityManagementFull, CapacityManagementMinMax, CapacityManagementCreateOnly:
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%q must be one of %q, %q or %q, got %q", k,
+		CapacityManagementFull, CapacityManagementMinMax, CapacityManagementCreateOnly, v)}
+}
@@ -0,0 +1,250 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+type DriftReportingMode string
+
+const (
+	DriftReportingDisabled DriftReportingMode = "disabled"
+	DriftReportingLog      DriftReportingMode = "log"
+	DriftReportingSummary  DriftReportingMode = "summary"
+
+	DriftSummary         FieldName = "drift_summary"
+	DriftSummaryField    FieldName = "field"
+	DriftSummaryOldValue FieldName = "old_value"
+	DriftSummaryNewValue FieldName = "new_value"
+
+	ResourceFieldDrift LogFormat = "[WARN] drift detected: resource=%s id=%s field=%s old=%s new=%s"
+)
+
+// DriftReporter is implemented by the provider meta to expose the configured drift reporting mode.
+type DriftReporter interface {
+	DriftReportingMode() DriftReportingMode
+}
+
+// DriftDetector compares the state of every field before and after the
+// field onRead functions ran, and reports the fields that changed outside Terraform.
+type DriftDetector struct {
+	resourceName ResourceName
+	mode         DriftReportingMode
+	fields       *GenericFields
+	old          map[string]interface{}
+}
+
+type FieldDrift struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//          Constructors
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// NewDriftSummaryField returns the computed field recording the drift found by the last refresh.
+func NewDriftSummaryField(resourceAffinity ResourceAffinity) *GenericField {
+	return NewGenericField(
+		resourceAffinity,
+		DriftSummary,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(DriftSummaryField): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(DriftSummaryOldValue): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(DriftSummaryNewValue): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// NewDriftDetector snapshots the current state of all readable fields.
+// Drift is not tracked for new resources, since there is no previous state to compare with.
+func (res *GenericResource) NewDriftDetector(resourceData *schema.ResourceData, meta interface{}) *DriftDetector {
+	detector := &DriftDetector{
+		resourceName: res.resourceName,
+		mode:         DriftReportingDisabled,
+		fields:       res.fields,
+	}
+
+	if reporter, ok := meta.(DriftReporter); ok {
+		detector.mode = reporter.DriftReportingMode()
+	}
+	if detector.mode == DriftReportingDisabled || resourceData.IsNewResource() {
+		detector.mode = DriftReportingDisabled
+		return detector
+	}
+
+	detector.old = make(map[string]interface{})
+	for _, field := range res.fields.fieldsMap {
+		if !detector.isTracked(field, resourceData) {
+			continue
+		}
+		detector.old[field.fieldNameStr] = normalizeDriftValue(resourceData.Get(field.fieldNameStr))
+	}
+	return detector
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//      Methods: DriftDetector
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// Report compares the snapshot with the values set by onRead, logs every drifted
+// field and, in summary mode, records them in the drift_summary attribute.
+func (detector *DriftDetector) Report(resourceData *schema.ResourceData) ([]*FieldDrift, error) {
+	if detector.mode == DriftReportingDisabled {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(detector.old))
+	for name := range detector.old {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// A freshly imported resource has no previous state, everything would look drifted.
+	// Its summary is still cleared below, so no stale drift survives.
+	if !hasNonZeroDriftValue(detector.old) {
+		names = nil
+	}
+
+	var drifts []*FieldDrift
+	for _, name := range names {
+		oldValue := detector.old[name]
+		newValue := normalizeDriftValue(resourceData.Get(name))
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		drift := &FieldDrift{
+			Field:    name,
+			OldValue: driftValueString(oldValue),
+			NewValue: driftValueString(newValue),
+		}
+		log.Printf(string(ResourceFieldDrift), detector.resourceName, resourceData.Id(), drift.Field, drift.OldValue, drift.NewValue)
+		drifts = append(drifts, drift)
+	}
+
+	if detector.mode == DriftReportingSummary {
+		if _, ok := detector.fields.schemaMap[string(DriftSummary)]; ok {
+			if err := resourceData.Set(string(DriftSummary), flattenDriftSummary(drifts)); err != nil {
+				return nil, fmt.Errorf(string(FailureFieldReadPattern), string(DriftSummary), err)
+			}
+		}
+	}
+	return drifts, nil
+}
+
+// isTracked reports whether the field is configurable, readable and not part of a planned change.
+func (detector *DriftDetector) isTracked(field *GenericField, resourceData *schema.ResourceData) bool {
+	if field.onRead == nil || field.fieldName == DriftSummary {
+		return false
+	}
+	if field.schema == nil || (!field.schema.Optional && !field.schema.Required) {
+		return false
+	}
+	return !resourceData.HasChange(field.fieldNameStr)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func normalizeDriftValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		items := make([]interface{}, 0, v.Len())
+		for _, item := range v.List() {
+			items = append(items, normalizeDriftValue(item))
+		}
+		// Sets are unordered, compare them by their serialized items
+		sort.Slice(items, func(i, j int) bool {
+			return driftValueString(items[i]) < driftValueString(items[j])
+		})
+		return items
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, normalizeDriftValue(item))
+		}
+		return items
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[key] = normalizeDriftValue(item)
+		}
+		return m
+	default:
+		return v
+	}
+}
+
+func hasNonZeroDriftValue(values map[string]interface{}) bool {
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case []interface{}:
+			if len(v) > 0 {
+				return true
+			}
+		case map[string]interface{}:
+			if len(v) > 0 {
+				return true
+			}
+		default:
+			if !reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func driftValueString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	if json, err := ToJson(value); err == nil {
+		return json
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func flattenDriftSummary(drifts []*FieldDrift) []interface{} {
+	result := make([]interface{}, 0, len(drifts))
+	for _, drift := range drifts {
+		m := make(map[string]interface{})
+		m[string(DriftSummaryField)] = drift.Field
+		m[string(DriftSummaryOldValue)] = drift.OldValue
+		m[string(DriftSummaryNewValue)] = drift.NewValue
+		result = append(result, m)
+	}
+	return result
+}
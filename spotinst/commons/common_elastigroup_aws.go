@@ -12,7 +12,8 @@ import (
 //            Variables
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 const (
-	ElastigroupAwsResourceName ResourceName = "spotinst_elastigroup_aws"
+	ElastigroupAwsResourceName            ResourceName = "spotinst_elastigroup_aws"
+	ElastigroupAwsInstancesDataSourceName ResourceName = "spotinst_elastigroup_aws_instances"
 )
 
 var ElastigroupResource *ElastigroupTerraformResource
@@ -55,6 +56,7 @@ func (res *ElastigroupTerraformResource) OnRead(
 	egWrapper := NewElastigroupWrapper()
 	egWrapper.SetElastigroup(elastigroup)
 
+	drift := res.NewDriftDetector(resourceData, meta)
 	for _, field := range res.fields.fieldsMap {
 		if field.onRead == nil {
 			continue
@@ -64,6 +66,9 @@ func (res *ElastigroupTerraformResource) OnRead(
 			return err
 		}
 	}
+	if _, err := drift.Report(resourceData); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,8 +120,9 @@ func NewElastigroupAzureWrapper() *ElastigroupAzureWrapper {
 				},
 				VMSizes: &azure.VMSizes{},
 			},
-			Capacity: &azure.Capacity{},
-			Strategy: &azure.Strategy{},
+			Capacity:    &azure.Capacity{},
+			Strategy:    &azure.Strategy{},
+			Integration: &azure.Integration{},
 		},
 	}
 }
@@ -24,6 +24,12 @@ type ElastigroupGCPWrapper struct {
 	elastigroup *gcp.Group
 }
 
+// GCPGroupWrapper is implemented by the wrappers of every resource backed by a GCP group,
+// which lets the elastigroup_gcp_* field packages be shared by the GCP and GKE resources.
+type GCPGroupWrapper interface {
+	GetElastigroup() *gcp.Group
+}
+
 // NewElastigroupGCPResource creates a new GCP resource
 func NewElastigroupGCPResource(fieldMap map[FieldName]*GenericField) *ElastigroupGCPTerraformResource {
 	return &ElastigroupGCPTerraformResource{
@@ -30,6 +30,10 @@ type ImportGKEWrapper struct {
 	elastigroup     *gcp.ImportGKEGroup
 	ClusterID       string
 	ClusterZoneName string
+
+	// group holds the fields which the import API does not accept,
+	// they are applied by updating the group once it was imported.
+	group *gcp.Group
 }
 
 // NewElastigroupGKEResource creates a new GKE resource
@@ -42,13 +46,14 @@ func NewElastigroupGKEResource(fieldMap map[FieldName]*GenericField) *Elastigrou
 	}
 }
 
-// OnCreate is called when creating a new resource block and returns a new elastigroup or an error.
+// OnCreate is called when creating a new resource block and returns the import request,
+// the group update to apply once the cluster was imported (nil if there is none), or an error.
 func (res *ElastigroupGKETerraformResource) OnCreate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (*gcp.ImportGKEGroup, error) {
+	meta interface{}) (*gcp.ImportGKEGroup, *gcp.Group, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot create")
 	}
 
 	gkeGroupImport := NewImportGKEWrapper()
@@ -60,10 +65,15 @@ func (res *ElastigroupGKETerraformResource) OnCreate(
 		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
 
 		if err := field.onCreate(gkeGroupImport, resourceData, meta); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return gkeGroupImport.GetImport(), nil
+
+	group, err := gkeGroupImport.GetPostImportUpdate()
+	if err != nil {
+		return nil, nil, err
+	}
+	return gkeGroupImport.GetImport(), group, nil
 }
 
 // OnRead is called when reading an existing resource and throws an error if it is unable to do so.
@@ -144,6 +154,7 @@ func NewImportGKEWrapper() *ImportGKEWrapper {
 		elastigroup: &gcp.ImportGKEGroup{
 			Capacity: &gcp.CapacityGKE{},
 		},
+		group: NewElastigroupGCPWrapper().GetElastigroup(),
 	}
 }
 
@@ -155,6 +166,28 @@ func (egWrapper *ImportGKEWrapper) SetImport(elastigroup *gcp.ImportGKEGroup) {
 	egWrapper.elastigroup = elastigroup
 }
 
+// GetElastigroup returns the group fields applied after the import, it makes
+// the elastigroup_gcp_* fields usable when creating a GKE group.
+func (egWrapper *ImportGKEWrapper) GetElastigroup() *gcp.Group {
+	return egWrapper.group
+}
+
+// GetPostImportUpdate returns the group fields to apply after the import, or nil if none were set.
+func (egWrapper *ImportGKEWrapper) GetPostImportUpdate() (*gcp.Group, error) {
+	empty, err := ToJson(NewElastigroupGCPWrapper().GetElastigroup())
+	if err != nil {
+		return nil, err
+	}
+	group, err := ToJson(egWrapper.group)
+	if err != nil {
+		return nil, err
+	}
+	if group == empty {
+		return nil, nil
+	}
+	return egWrapper.group, nil
+}
+
 // GetElastigroup returns a wrapped elastigroup
 func (egWrapper *ElastigroupGKEWrapper) GetElastigroup() *gcp.Group {
 	return egWrapper.elastigroup
@@ -68,6 +68,7 @@ func (res *MRScalerAWSTerraformResource) OnRead(
 	mrsWrapper := NewMRScalerAWSWrapper()
 	mrsWrapper.SetMRScalerAWS(mrscaler)
 
+	drift := res.NewDriftDetector(resourceData, meta)
 	for _, field := range res.fields.fieldsMap {
 		if field.onRead == nil {
 			continue
@@ -77,6 +78,9 @@ func (res *MRScalerAWSTerraformResource) OnRead(
 			return err
 		}
 	}
+	if _, err := drift.Report(resourceData); err != nil {
+		return err
+	}
 	return nil
 }
 
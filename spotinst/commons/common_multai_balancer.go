@@ -11,7 +11,8 @@ import (
 //            Variables
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 const (
-	MultaiBalancerResourceName ResourceName = "spotinst_multai_balancer"
+	MultaiBalancerResourceName   ResourceName = "spotinst_multai_balancer"
+	MultaiBalancerDataSourceName ResourceName = "spotinst_multai_balancer"
 )
 
 var MultaiBalancerResource *MultaiBalancerTerraformResource
@@ -11,7 +11,9 @@ import (
 //            Variables
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 const (
-	MultaiTargetSetResourceName ResourceName = "spotinst_multai_target_set"
+	MultaiTargetSetResourceName           ResourceName = "spotinst_multai_target_set"
+	MultaiTargetSetAttachmentResourceName ResourceName = "spotinst_multai_target_set_attachment"
+	MultaiTargetSetHealthDataSourceName   ResourceName = "spotinst_multai_target_set_health"
 )
 
 var MultaiTargetSetResource *MultaiTargetSetTerraformResource
@@ -11,7 +11,8 @@ import (
 //            Variables
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 const (
-	OceanAWSResourceName ResourceName = "spotinst_ocean_aws"
+	OceanAWSResourceName       ResourceName = "spotinst_ocean_aws"
+	OceanAWSDetachResourceName ResourceName = "spotinst_ocean_aws_detach"
 )
 
 var OceanResource *OceanAWSTerraformResource
@@ -67,6 +68,7 @@ func (res *OceanAWSTerraformResource) OnRead(
 	clusterWrapper := NewClusterWrapper()
 	clusterWrapper.SetCluster(cluster)
 
+	drift := res.NewDriftDetector(resourceData, meta)
 	for _, field := range res.fields.fieldsMap {
 		if field.onRead == nil {
 			continue
@@ -77,6 +79,9 @@ func (res *OceanAWSTerraformResource) OnRead(
 		}
 	}
 
+	if _, err := drift.Report(resourceData); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12,7 +12,8 @@ import (
 //            Variables
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 const (
-	SubscriptionResourceName ResourceName = "spotinst_subscription"
+	SubscriptionResourceName    ResourceName = "spotinst_subscription"
+	SubscriptionSetResourceName ResourceName = "spotinst_subscription_set"
 )
 
 var SubscriptionResource *SubscriptionTerraformResource
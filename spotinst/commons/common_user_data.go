@@ -0,0 +1,378 @@
+package commons
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// UserDataLimit holds the maximum size of a user data payload accepted by a cloud.
+// The size is measured on the decoded payload, i.e. after base64 decoding and
+// before any gzip decompression done by the instance.
+type UserDataLimit struct {
+	Cloud   string
+	MaxSize int
+}
+
+// UserDataPart is a single part of a multipart cloud-init document.
+type UserDataPart struct {
+	ContentType string
+	Filename    string
+	MergeType   string
+	Content     string
+}
+
+var (
+	UserDataLimitAWS   = &UserDataLimit{Cloud: "AWS", MaxSize: 16 * 1024}
+	UserDataLimitGCP   = &UserDataLimit{Cloud: "GCP", MaxSize: 256 * 1024}
+	UserDataLimitAzure = &UserDataLimit{Cloud: "Azure", MaxSize: 64*1024 - 1}
+)
+
+const (
+	UserDataPartField       FieldName = "user_data_part"
+	UserDataPartContentType FieldName = "content_type"
+	UserDataPartFilename    FieldName = "filename"
+	UserDataPartMergeType   FieldName = "merge_type"
+	UserDataPartContent     FieldName = "content"
+
+	// EmptyUserDataHash is the hash stored in state for an empty payload.
+	EmptyUserDataHash = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+	// The boundary is fixed so that composing the same parts always results in the same document.
+	userDataMultipartBoundary = "MIMEBOUNDARY"
+	userDataDefaultPartType   = "text/x-shellscript"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//          Constructors
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// NewUserDataSchema returns the schema of a user data like field (user_data, startup_script,
+// shutdown_script). The value may be raw text, base64 or gzip+base64, only its hash is kept in state.
+func NewUserDataSchema(limit *UserDataLimit) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Sometimes the API responds with the equivalent, empty SHA1 sum
+			return (old == EmptyUserDataHash && new == "") || (old == "" && new == EmptyUserDataHash)
+		},
+		StateFunc:    UserDataStateFunc,
+		ValidateFunc: limit.Validate,
+	}
+}
+
+// NewUserDataPartsField returns a field composing a multipart cloud-init document from several
+// parts and storing it in the user data of the resource object. It conflicts with userDataField,
+// which must skip its own onRead and onUpdate when parts are configured (see HasUserDataParts).
+func NewUserDataPartsField(
+	resourceAffinity ResourceAffinity,
+	userDataField FieldName,
+	limit *UserDataLimit,
+	getUserData func(resourceObject interface{}) *string,
+	setUserData func(resourceObject interface{}, value *string)) *GenericField {
+
+	return NewGenericField(
+		resourceAffinity,
+		UserDataPartField,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{string(userDataField)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(UserDataPartContentType): {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  userDataDefaultPartType,
+					},
+
+					string(UserDataPartFilename): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(UserDataPartMergeType): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(UserDataPartContent): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !HasUserDataParts(resourceData) {
+				return nil
+			}
+			document, err := ComposeMultipartUserData(expandUserDataParts(resourceData))
+			if err != nil {
+				return fmt.Errorf(string(FailureFieldReadPattern), string(UserDataPartField), err)
+			}
+			// The parts cannot be recovered from the document, drop them
+			// when the user data was changed outside Terraform.
+			if UserDataStateFromAPI(getUserData(resourceObject)) != UserDataStateFunc(document) {
+				if err := resourceData.Set(string(UserDataPartField), nil); err != nil {
+					return fmt.Errorf(string(FailureFieldReadPattern), string(UserDataPartField), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !HasUserDataParts(resourceData) {
+				return nil
+			}
+			userData, err := composeUserDataParts(resourceData, limit)
+			if err != nil {
+				return fmt.Errorf(string(FailureFieldCreatePattern), string(UserDataPartField), err)
+			}
+			setUserData(resourceObject, userData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !HasUserDataParts(resourceData) {
+				// Clear the document unless it was replaced by the conflicting field.
+				if v, ok := resourceData.Get(string(userDataField)).(string); !ok || v == "" {
+					setUserData(resourceObject, nil)
+				}
+				return nil
+			}
+			userData, err := composeUserDataParts(resourceData, limit)
+			if err != nil {
+				return fmt.Errorf(string(FailureFieldUpdatePattern), string(UserDataPartField), err)
+			}
+			setUserData(resourceObject, userData)
+			return nil
+		},
+		nil,
+	)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//      Methods: UserDataLimit
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// Validate is a schema.SchemaValidateFunc rejecting payloads larger than the limit,
+// or gzip payloads which cannot be decompressed.
+func (limit *UserDataLimit) Validate(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q must be a string", k)}
+	}
+	if err := limit.check(userDataPayload(value)); err != nil {
+		return nil, []error{fmt.Errorf("%q %v", k, err)}
+	}
+	return nil, nil
+}
+
+func (limit *UserDataLimit) check(payload []byte) error {
+	if len(payload) > limit.MaxSize {
+		return fmt.Errorf("is %d bytes long, %s allows at most %d bytes (consider gzip compression)",
+			len(payload), limit.Cloud, limit.MaxSize)
+	}
+	if isGzipped(payload) {
+		reader, err := gzip.NewReader(bytes.NewReader(payload))
+		if err == nil {
+			_, err = ioutil.ReadAll(reader)
+		}
+		if err != nil {
+			return fmt.Errorf("is not a valid gzip stream: %v", err)
+		}
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// UserDataStateFunc hashes the decoded payload, so the same script yields the same
+// hash whether it is configured as raw text, as base64 or read back from the API.
+func UserDataStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return userDataHash(userDataPayload(s))
+	default:
+		return ""
+	}
+}
+
+// UserDataStateFromAPI returns the state value of a base64 encoded payload returned by the API.
+func UserDataStateFromAPI(encoded *string) string {
+	if encoded == nil || *encoded == "" {
+		return EmptyUserDataHash
+	}
+	payload, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		payload = []byte(*encoded)
+	}
+	return userDataHash(payload)
+}
+
+// EncodeUserData returns the base64 encoded payload expected by the API.
+// Values which are already base64 encoded are returned unchanged.
+func EncodeUserData(value string) string {
+	return base64.StdEncoding.EncodeToString(userDataPayload(value))
+}
+
+// HasUserDataParts reports whether the resource composes its user data from parts.
+func HasUserDataParts(resourceData *schema.ResourceData) bool {
+	parts, ok := resourceData.Get(string(UserDataPartField)).([]interface{})
+	return ok && len(parts) > 0
+}
+
+// ComposeMultipartUserData renders the parts as a multipart MIME document understood by cloud-init.
+func ComposeMultipartUserData(parts []*UserDataPart) (string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(userDataMultipartBoundary); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", userDataMultipartBoundary)
+	buf.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	for i, part := range parts {
+		if strings.Contains(part.Content, "--"+userDataMultipartBoundary) {
+			return "", fmt.Errorf("part %d contains the reserved boundary %q", i, userDataMultipartBoundary)
+		}
+
+		contentType := part.ContentType
+		if contentType == "" {
+			contentType = userDataDefaultPartType
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", contentType)
+		header.Set("MIME-Version", "1.0")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+		}
+		if part.MergeType != "" {
+			header.Set("X-Merge-Type", part.MergeType)
+		}
+
+		content := part.Content
+		if isSevenBit(content) {
+			header.Set("Content-Transfer-Encoding", "7bit")
+		} else {
+			header.Set("Content-Transfer-Encoding", "base64")
+			content = base64.StdEncoding.EncodeToString([]byte(content))
+		}
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func composeUserDataParts(resourceData *schema.ResourceData, limit *UserDataLimit) (*string, error) {
+	document, err := ComposeMultipartUserData(expandUserDataParts(resourceData))
+	if err != nil {
+		return nil, err
+	}
+	if err := limit.check([]byte(document)); err != nil {
+		return nil, fmt.Errorf("composed user data %v", err)
+	}
+	encoded := EncodeUserData(document)
+	return &encoded, nil
+}
+
+func expandUserDataParts(resourceData *schema.ResourceData) []*UserDataPart {
+	list, _ := resourceData.Get(string(UserDataPartField)).([]interface{})
+	parts := make([]*UserDataPart, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		part := &UserDataPart{}
+		if v, ok := m[string(UserDataPartContentType)].(string); ok {
+			part.ContentType = v
+		}
+		if v, ok := m[string(UserDataPartFilename)].(string); ok {
+			part.Filename = v
+		}
+		if v, ok := m[string(UserDataPartMergeType)].(string); ok {
+			part.MergeType = v
+		}
+		if v, ok := m[string(UserDataPartContent)].(string); ok {
+			part.Content = v
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// userDataPayload returns the bytes the instance will receive. A value is considered
+// base64 encoded when it decodes to either a gzip stream or text; anything else
+// (e.g. a short script which happens to be valid base64) is taken as raw text.
+func userDataPayload(value string) []byte {
+	if value != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+			if isGzipped(decoded) || isText(decoded) {
+				return decoded
+			}
+		}
+	}
+	return []byte(value)
+}
+
+func userDataHash(payload []byte) string {
+	hash := sha1.Sum(payload)
+	return hex.EncodeToString(hash[:])
+}
+
+func isGzipped(payload []byte) bool {
+	return bytes.HasPrefix(payload, gzipMagic)
+}
+
+// isText reports whether payload is valid UTF-8 without control characters other than whitespace.
+func isText(payload []byte) bool {
+	if !utf8.Valid(payload) {
+		return false
+	}
+	for _, b := range payload {
+		if b < 0x20 && b != '\t' && b != '\n' && b != '\r' {
+			return false
+		}
+	}
+	return true
+}
+
+func isSevenBit(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 || s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+package commons
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestUserDataStateFunc(t *testing.T) {
+	script := "#!/bin/bash\necho hello world\n"
+	encoded := base64.StdEncoding.EncodeToString([]byte(script))
+
+	if raw, b64 := UserDataStateFunc(script), UserDataStateFunc(encoded); raw != b64 {
+		t.Fatalf("expected raw and base64 input to hash the same, got %q and %q", raw, b64)
+	}
+	if got := UserDataStateFromAPI(&encoded); got != UserDataStateFunc(script) {
+		t.Fatalf("expected the API value to hash as the configured value, got %q", got)
+	}
+	if got := UserDataStateFunc(""); got != EmptyUserDataHash {
+		t.Fatalf("expected empty hash, got %q", got)
+	}
+	if got := EncodeUserData(encoded); got != encoded {
+		t.Fatalf("expected base64 input to be sent unchanged, got %q", got)
+	}
+	if got := EncodeUserData(script); got != encoded {
+		t.Fatalf("expected raw input to be base64 encoded, got %q", got)
+	}
+
+	// "echo" is valid base64 but does not decode to text.
+	if got := EncodeUserData("echo"); got != base64.StdEncoding.EncodeToString([]byte("echo")) {
+		t.Fatalf("expected short scripts to be treated as raw text, got %q", got)
+	}
+}
+
+func TestUserDataGzip(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(strings.Repeat("echo hello world\n", 2000)))
+	w.Close()
+	compressed := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	if got := EncodeUserData(compressed); got != compressed {
+		t.Fatalf("expected gzip+base64 input to be sent unchanged")
+	}
+	if got := UserDataStateFromAPI(&compressed); got != UserDataStateFunc(compressed) {
+		t.Fatalf("expected the API value to hash as the configured value, got %q", got)
+	}
+	if _, errs := UserDataLimitAWS.Validate(compressed, "user_data"); len(errs) != 0 {
+		t.Fatalf("unexpected errors for compressed payload: %v", errs)
+	}
+
+	corrupt := base64.StdEncoding.EncodeToString(buf.Bytes()[:20])
+	if _, errs := UserDataLimitAWS.Validate(corrupt, "user_data"); len(errs) == 0 {
+		t.Fatalf("expected an error for a truncated gzip payload")
+	}
+}
+
+func TestUserDataLimit(t *testing.T) {
+	large := strings.Repeat("x", 20*1024)
+
+	if _, errs := UserDataLimitAWS.Validate(large, "user_data"); len(errs) == 0 {
+		t.Fatalf("expected an error for a payload above the AWS limit")
+	}
+	if _, errs := UserDataLimitGCP.Validate(large, "startup_script"); len(errs) != 0 {
+		t.Fatalf("unexpected errors below the GCP limit: %v", errs)
+	}
+}
+
+func TestComposeMultipartUserData(t *testing.T) {
+	parts := []*UserDataPart{
+		{ContentType: "text/cloud-config", Filename: "init.cfg", Content: "packages:\n  - nginx\n"},
+		{MergeType: "list(append)+dict(recurse_array)", Content: "#!/bin/bash\necho héllo\n"},
+	}
+
+	first, err := ComposeMultipartUserData(parts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, _ := ComposeMultipartUserData(parts)
+	if first != second {
+		t.Fatalf("expected composition to be deterministic")
+	}
+
+	for _, expected := range []string{
+		`Content-Type: multipart/mixed; boundary="MIMEBOUNDARY"`,
+		`Content-Type: text/cloud-config`,
+		`Content-Disposition: attachment; filename="init.cfg"`,
+		`Content-Type: text/x-shellscript`,
+		`X-Merge-Type: list(append)+dict(recurse_array)`,
+		`Content-Transfer-Encoding: base64`,
+		"--MIMEBOUNDARY--",
+	} {
+		if !strings.Contains(first, expected) {
+			t.Fatalf("expected document to contain %q, got:\n%s", expected, first)
+		}
+	}
+
+	if _, err := ComposeMultipartUserData([]*UserDataPart{{Content: "--MIMEBOUNDARY"}}); err == nil {
+		t.Fatalf("expected an error for a part containing the boundary")
+	}
+}
@@ -13,8 +13,9 @@ const (
 	FieldUpdateNotAllowedPattern = "field [%v] is immutable, cannot be changed post group creation"
 	FieldCreateNotAllowedPattern = "field [%v] can only be changed after the group is created"
 
-	ProviderToken   FieldName = "token"
-	ProviderAccount FieldName = "account"
+	ProviderToken          FieldName = "token"
+	ProviderAccount        FieldName = "account"
+	ProviderDriftReporting FieldName = "drift_reporting"
 
 	Subscription            ResourceAffinity = "Subscription"
 	ElastigroupAWSBeanstalk ResourceAffinity = "ElastigroupAWSBeanstalk"
@@ -59,6 +60,8 @@ const (
 	ElastigroupAzureLaunchConfiguration ResourceAffinity = "Elastigroup_Azure_Launch_Configuration"
 	ElastigroupAzureHealthCheck         ResourceAffinity = "Elastigroup_Azure_Health_Check"
 	ElastigroupAzureScheduledTask       ResourceAffinity = "Elastigroup_Azure_Scheduled_Task"
+	ElastigroupAzureScalingPolicies     ResourceAffinity = "Elastigroup_Azure_Scaling_Policies"
+	ElastigroupAzureIntegrations        ResourceAffinity = "Elastigroup_Azure_Integrations"
 
 	MRScalerAWS                    ResourceAffinity = "MRScaler_AWS"
 	MRScalerAWSTaskScalingPolicies ResourceAffinity = "MRScaler_Task_AWS_Scaling_Polices"
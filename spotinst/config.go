@@ -18,6 +18,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
 )
 
 var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
@@ -28,6 +29,13 @@ var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
 type Config struct {
 	Token   string
 	Account string
+
+	// BaseURL overrides the Spotinst API endpoint, e.g. to point the client
+	// at a local stand-in of the API. Optional.
+	BaseURL string
+
+	// DriftReporting controls how changes made outside Terraform are reported on refresh.
+	DriftReporting string
 }
 
 type Client struct {
@@ -37,6 +45,8 @@ type Client struct {
 	multai       multai.Service
 	mrscaler     mrscaler.Service
 	ocean        ocean.Service
+
+	driftReporting commons.DriftReportingMode
 }
 
 // Validate returns an error in case of invalid configuration.
@@ -50,6 +60,10 @@ func (c *Config) Client() (*Client, error) {
 	config.WithLogger(newStdLogger("DEBUG"))
 	config.WithUserAgent("HashiCorp-Terraform/" + terraform.VersionString() + ",spotinst-provider/v2-" + version.GetShortVersion())
 
+	if c.BaseURL != "" {
+		config.WithBaseURL(c.BaseURL)
+	}
+
 	// Set user credentials.
 	providers := []credentials.Provider{
 		new(credentials.EnvProvider),
@@ -86,12 +100,22 @@ func (c *Config) Client() (*Client, error) {
 		multai:       multai.New(sess),
 		mrscaler:     mrscaler.New(sess),
 		ocean:        ocean.New(sess),
+
+		driftReporting: commons.DriftReportingMode(c.DriftReporting),
 	}
 	stdlog.Println("[INFO] Spotinst client configured")
 
 	return client, nil
 }
 
+// DriftReportingMode implements commons.DriftReporter.
+func (c *Client) DriftReportingMode() commons.DriftReportingMode {
+	if c.driftReporting == "" {
+		return commons.DriftReportingDisabled
+	}
+	return c.driftReporting
+}
+
 func newStdLogger(level string) log.Logger {
 	return log.LoggerFunc(func(format string, args ...interface{}) {
 		stdlog.Printf(fmt.Sprintf("[%s] %s", strings.ToUpper(level), format), args...)
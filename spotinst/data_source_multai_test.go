@@ -0,0 +1,131 @@
+package spotinst
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMultaiBalancerDataSource(t *testing.T) {
+	api := newTestCollectionAPI("/loadBalancer/balancer", "balancer", "lb-")
+	defer api.Close()
+	api.Add(map[string]interface{}{"id": "lb-0", "deploymentId": "dp-1"})
+	api.Add(map[string]interface{}{"id": "lb-1", "deploymentId": "dp-2"})
+	api.Add(map[string]interface{}{"id": "lb-2"})
+	api.Handle("GET", "/loadBalancer/balancer/lb-1",
+		`{"id": "lb-1", "name": "web", "scheme": "internet-facing", "dnsRrType": "CNAME", "dnsRrName": "web.example.com", "dnsCnameAliases": ["lb-1.spotinst.io", "lb-1-b.spotinst.io"]}`)
+	api.Handle("GET", "/loadBalancer/balancer/lb-2", `{"id": "lb-2", "name": "idle"}`)
+	api.Handle("GET", "/loadBalancer/targetSet", `{"id": "ts-1", "balancerId": "lb-1"}`)
+	api.Handle("GET", "/loadBalancer/deployment", `{"id": "dp-1"}`, `{"id": "dp-2"}`)
+	api.Handle("GET", "/loadBalancer/runtime",
+		`{"id": "rt-1", "ip": "10.0.0.1", "version": "1.2.0", "isLeader": false, "status": {"readiness": "READY", "healthiness": "HEALTHY"}, "lastReported": "2018-11-01T10:00:00Z"}`,
+		`{"id": "rt-2", "ip": "10.0.0.2", "version": "1.2.0", "isLeader": true, "status": {"readiness": "draining", "healthiness": "flapping"}}`)
+
+	cases := []struct {
+		balancerId string
+		expected   map[string]string
+	}{
+		{
+			balancerId: "lb-1",
+			expected: map[string]string{
+				"name":                        "web",
+				"deployment_id":               "dp-2",
+				"dns_rr_name":                 "web.example.com",
+				"dns_cname_aliases.#":         "2",
+				"dns_cname_aliases.0":         "lb-1.spotinst.io",
+				"runtimes.#":                  "2",
+				"runtimes.0.healthiness":      "HEALTHY",
+				"runtimes.0.last_reported_at": "2018-11-01T10:00:00Z",
+				"runtimes.1.readiness":        "DRAINING",
+				"runtimes.1.healthiness":      "UNKNOWN",
+				"runtime_ips.#":               "2",
+				"leader_ip":                   "10.0.0.2",
+			},
+		},
+		{
+			// A balancer in no deployment has no runtimes.
+			balancerId: "lb-2",
+			expected: map[string]string{
+				"name":          "idle",
+				"deployment_id": "",
+				"runtimes.#":    "0",
+				"runtime_ips.#": "0",
+				"leader_ip":     "",
+			},
+		},
+	}
+
+	res := dataSourceSpotinstMultaiBalancer()
+	for _, c := range cases {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"balancer_id": c.balancerId})
+		if err := res.Read(resourceData, api.Client(t)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.balancerId, err)
+		}
+
+		state := resourceData.State().Attributes
+		for key, value := range c.expected {
+			if state[key] != value {
+				t.Fatalf("%s: expected %s to be %q, got %q", c.balancerId, key, value, state[key])
+			}
+		}
+	}
+}
+
+func TestMultaiBalancerDataSourceTargetSetDeployment(t *testing.T) {
+	// The deployment of a target set is used without searching deployments,
+	// which have no stand-in here.
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/loadBalancer/balancer/lb-1", `{"id": "lb-1", "name": "web"}`)
+	api.Handle("GET", "/loadBalancer/targetSet", `{"id": "ts-1", "balancerId": "lb-1", "deploymentId": "dp-3"}`)
+	api.Handle("GET", "/loadBalancer/runtime", `{"id": "rt-1", "ip": "10.0.0.1", "isLeader": true}`)
+
+	res := dataSourceSpotinstMultaiBalancer()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"balancer_id": "lb-1"})
+	if err := res.Read(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resourceData.Get("deployment_id").(string); got != "dp-3" {
+		t.Fatalf("expected deployment dp-3, got %q", got)
+	}
+	if got := resourceData.Get("leader_ip").(string); got != "10.0.0.1" {
+		t.Fatalf("expected leader 10.0.0.1, got %q", got)
+	}
+}
+
+func TestMultaiTargetSetHealthDataSource(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/loadBalancer/target",
+		`{"id": "t-2", "host": "10.0.0.2", "port": 80, "weight": 1, "status": {"readiness": "READY", "healthiness": "UNHEALTHY"}}`,
+		`{"id": "t-1", "host": "10.0.0.1", "port": 80, "weight": 1, "status": {"readiness": "READY", "healthiness": "HEALTHY"}}`,
+		`{"id": "t-3", "host": "10.0.0.3", "port": 80, "weight": 1}`)
+
+	res := dataSourceSpotinstMultaiTargetSetHealth()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"target_set_id": "ts-1",
+	})
+	if err := res.Read(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"targets.#":             "3",
+		"targets.0.target_id":   "t-1",
+		"targets.0.healthiness": "HEALTHY",
+		"targets.2.healthiness": "UNKNOWN",
+		"healthy_targets.0":     "10.0.0.1:80",
+		"unhealthy_targets.0":   "10.0.0.2:80",
+		"healthy_count":         "1",
+		"unhealthy_count":       "1",
+		"unknown_count":         "1",
+		"all_healthy":           "false",
+	}
+	state := resourceData.State().Attributes
+	for key, value := range expected {
+		if state[key] != value {
+			t.Fatalf("expected %s to be %q, got %q", key, value, state[key])
+		}
+	}
+
+}
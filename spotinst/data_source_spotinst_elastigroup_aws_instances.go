@@ -0,0 +1,229 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	ElastigroupInstancesGroupId         commons.FieldName = "group_id"
+	ElastigroupInstancesLifecycle       commons.FieldName = "lifecycle"
+	ElastigroupInstancesLifecycleFilter commons.FieldName = "instance_lifecycle"
+	ElastigroupInstancesHealthStatus    commons.FieldName = "health_status"
+	ElastigroupInstances                commons.FieldName = "instances"
+	ElastigroupInstancesInstanceId      commons.FieldName = "instance_id"
+	ElastigroupInstancesInstanceType    commons.FieldName = "instance_type"
+	ElastigroupInstancesZone            commons.FieldName = "availability_zone"
+	ElastigroupInstancesPrivateIp       commons.FieldName = "private_ip"
+	ElastigroupInstancesPublicIp        commons.FieldName = "public_ip"
+	ElastigroupInstancesStatus          commons.FieldName = "status"
+	ElastigroupInstancesInstanceIds     commons.FieldName = "instance_ids"
+	ElastigroupInstancesPrivateIps      commons.FieldName = "private_ips"
+	ElastigroupInstancesPublicIps       commons.FieldName = "public_ips"
+)
+
+const (
+	instanceLifecycleSpot     = "SPOT"
+	instanceLifecycleOnDemand = "OD"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Data Source
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func dataSourceSpotinstElastigroupAwsInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstElastigroupAwsInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			string(ElastigroupInstancesGroupId): {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			string(ElastigroupInstancesLifecycleFilter): {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					switch strings.ToUpper(v.(string)) {
+					case instanceLifecycleSpot, instanceLifecycleOnDemand:
+						return nil, nil
+					}
+					return nil, []error{fmt.Errorf("%q must be one of %q or %q, got %q", k,
+						strings.ToLower(instanceLifecycleSpot), strings.ToLower(instanceLifecycleOnDemand), v)}
+				},
+			},
+
+			string(ElastigroupInstancesHealthStatus): {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			string(ElastigroupInstances): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(ElastigroupInstancesInstanceId): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesInstanceType): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesLifecycle): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesZone): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesPrivateIp): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesPublicIp): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesStatus): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(ElastigroupInstancesHealthStatus): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			string(ElastigroupInstancesInstanceIds): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(ElastigroupInstancesPrivateIps): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(ElastigroupInstancesPublicIps): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstElastigroupAwsInstancesRead(resourceData *schema.ResourceData, meta interface{}) error {
+	groupId := resourceData.Get(string(ElastigroupInstancesGroupId)).(string)
+	log.Printf("===> Reading instances of elastigroup: %s <===", groupId)
+
+	svc := meta.(*Client).elastigroup.CloudProviderAWS()
+	status, err := svc.Status(context.Background(), &aws.StatusGroupInput{GroupID: spotinst.String(groupId)})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to get the status of group %s: %s", groupId, err)
+	}
+
+	healthiness, err := svc.GetInstanceHealthiness(context.Background(), &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(groupId)})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to get the instance healthiness of group %s: %s", groupId, err)
+	}
+
+	health := make(map[string]*aws.InstanceHealth)
+	for _, instance := range healthiness.Instances {
+		health[spotinst.StringValue(instance.InstanceID)] = instance
+	}
+
+	lifecycleFilter := strings.ToUpper(resourceData.Get(string(ElastigroupInstancesLifecycleFilter)).(string))
+	healthFilter := strings.ToUpper(resourceData.Get(string(ElastigroupInstancesHealthStatus)).(string))
+
+	instances := make([]interface{}, 0, len(status.Instances))
+	instanceIds := make([]string, 0, len(status.Instances))
+	privateIps := make([]string, 0, len(status.Instances))
+	publicIps := make([]string, 0, len(status.Instances))
+
+	for _, instance := range status.Instances {
+		// Pending spot requests have no instance yet.
+		if instance.ID == nil {
+			continue
+		}
+		id := spotinst.StringValue(instance.ID)
+
+		lifecycle := instanceLifecycleOnDemand
+		if instance.SpotRequestID != nil {
+			lifecycle = instanceLifecycleSpot
+		}
+		healthStatus := ""
+		if h, ok := health[id]; ok {
+			if h.LifeCycle != nil {
+				lifecycle = strings.ToUpper(spotinst.StringValue(h.LifeCycle))
+			}
+			healthStatus = spotinst.StringValue(h.HealthStatus)
+		}
+
+		if lifecycleFilter != "" && lifecycle != lifecycleFilter {
+			continue
+		}
+		if healthFilter != "" && strings.ToUpper(healthStatus) != healthFilter {
+			continue
+		}
+
+		m := make(map[string]interface{})
+		m[string(ElastigroupInstancesInstanceId)] = id
+		m[string(ElastigroupInstancesInstanceType)] = spotinst.StringValue(instance.InstanceType)
+		m[string(ElastigroupInstancesLifecycle)] = lifecycle
+		m[string(ElastigroupInstancesZone)] = spotinst.StringValue(instance.AvailabilityZone)
+		m[string(ElastigroupInstancesPrivateIp)] = spotinst.StringValue(instance.PrivateIP)
+		m[string(ElastigroupInstancesPublicIp)] = spotinst.StringValue(instance.PublicIP)
+		m[string(ElastigroupInstancesStatus)] = spotinst.StringValue(instance.Status)
+		m[string(ElastigroupInstancesHealthStatus)] = healthStatus
+		instances = append(instances, m)
+
+		instanceIds = append(instanceIds, id)
+		if instance.PrivateIP != nil {
+			privateIps = append(privateIps, spotinst.StringValue(instance.PrivateIP))
+		}
+		if instance.PublicIP != nil {
+			publicIps = append(publicIps, spotinst.StringValue(instance.PublicIP))
+		}
+	}
+
+	if err := resourceData.Set(string(ElastigroupInstances), instances); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupInstances), err)
+	}
+	if err := resourceData.Set(string(ElastigroupInstancesInstanceIds), instanceIds); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupInstancesInstanceIds), err)
+	}
+	if err := resourceData.Set(string(ElastigroupInstancesPrivateIps), privateIps); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupInstancesPrivateIps), err)
+	}
+	if err := resourceData.Set(string(ElastigroupInstancesPublicIps), publicIps); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupInstancesPublicIps), err)
+	}
+
+	resourceData.SetId(groupId)
+	return nil
+}
@@ -0,0 +1,287 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	MultaiBalancerDataBalancerId      commons.FieldName = "balancer_id"
+	MultaiBalancerDataDeploymentId    commons.FieldName = "deployment_id"
+	MultaiBalancerDataName            commons.FieldName = "name"
+	MultaiBalancerDataScheme          commons.FieldName = "scheme"
+	MultaiBalancerDataDNSRRType       commons.FieldName = "dns_rr_type"
+	MultaiBalancerDataDNSRRName       commons.FieldName = "dns_rr_name"
+	MultaiBalancerDataDNSCNAMEAliases commons.FieldName = "dns_cname_aliases"
+	MultaiBalancerDataRuntimes        commons.FieldName = "runtimes"
+	MultaiBalancerDataRuntimeId       commons.FieldName = "runtime_id"
+	MultaiBalancerDataIP              commons.FieldName = "ip"
+	MultaiBalancerDataVersion         commons.FieldName = "version"
+	MultaiBalancerDataLeader          commons.FieldName = "leader"
+	MultaiBalancerDataReadiness       commons.FieldName = "readiness"
+	MultaiBalancerDataHealthiness     commons.FieldName = "healthiness"
+	MultaiBalancerDataLastReportedAt  commons.FieldName = "last_reported_at"
+	MultaiBalancerDataRuntimeIps      commons.FieldName = "runtime_ips"
+	MultaiBalancerDataLeaderIp        commons.FieldName = "leader_ip"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Data Source
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func dataSourceSpotinstMultaiBalancer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiBalancerRead,
+
+		Schema: map[string]*schema.Schema{
+			string(MultaiBalancerDataBalancerId): {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			string(MultaiBalancerDataDeploymentId): {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataName): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataScheme): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataDNSRRType): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataDNSRRName): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataDNSCNAMEAliases): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataRuntimes): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(MultaiBalancerDataRuntimeId): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataIP): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataVersion): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataLeader): {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataReadiness): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataHealthiness): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(MultaiBalancerDataLastReportedAt): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			string(MultaiBalancerDataRuntimeIps): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(MultaiBalancerDataLeaderIp): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiBalancerRead(resourceData *schema.ResourceData, meta interface{}) error {
+	balancerId := resourceData.Get(string(MultaiBalancerDataBalancerId)).(string)
+	log.Printf("===> Reading multai balancer: %s <===", balancerId)
+
+	client := meta.(*Client)
+	resp, err := client.multai.ReadLoadBalancer(context.Background(), &multai.ReadLoadBalancerInput{
+		BalancerID: spotinst.String(balancerId),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to read balancer %s: %s", balancerId, err)
+	}
+	balancer := resp.Balancer
+	if balancer == nil {
+		return fmt.Errorf("[ERROR] Balancer %s not found", balancerId)
+	}
+
+	for fieldName, value := range map[commons.FieldName]*string{
+		MultaiBalancerDataName:      balancer.Name,
+		MultaiBalancerDataScheme:    balancer.Scheme,
+		MultaiBalancerDataDNSRRType: balancer.DNSRRType,
+		MultaiBalancerDataDNSRRName: balancer.DNSRRName,
+	} {
+		if err := resourceData.Set(string(fieldName), spotinst.StringValue(value)); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+		}
+	}
+	if err := resourceData.Set(string(MultaiBalancerDataDNSCNAMEAliases), balancer.DNSCNAMEAliases); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiBalancerDataDNSCNAMEAliases), err)
+	}
+
+	deploymentId := resourceData.Get(string(MultaiBalancerDataDeploymentId)).(string)
+	if deploymentId == "" {
+		if deploymentId, err = findMultaiBalancerDeployment(client, balancerId); err != nil {
+			return err
+		}
+	}
+	if err := resourceData.Set(string(MultaiBalancerDataDeploymentId), deploymentId); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiBalancerDataDeploymentId), err)
+	}
+
+	// A balancer outside of any deployment has no runtimes.
+	runtimes := make([]interface{}, 0)
+	runtimeIps := make([]string, 0)
+	leaderIp := ""
+	if deploymentId != "" {
+		resp, err := client.multai.ListRuntimes(context.Background(), &multai.ListRuntimesInput{
+			DeploymentID: spotinst.String(deploymentId),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Failed to list the runtimes of deployment %s: %s", deploymentId, err)
+		}
+
+		for _, runtime := range resp.Runtimes {
+			ip := spotinst.StringValue(runtime.IPAddr)
+			readiness, healthiness := multaiStatus(runtime.Status)
+			lastReportedAt := ""
+			if runtime.LastReportedAt != nil {
+				lastReportedAt = runtime.LastReportedAt.Format(time.RFC3339)
+			}
+
+			m := make(map[string]interface{})
+			m[string(MultaiBalancerDataRuntimeId)] = spotinst.StringValue(runtime.ID)
+			m[string(MultaiBalancerDataIP)] = ip
+			m[string(MultaiBalancerDataVersion)] = spotinst.StringValue(runtime.Version)
+			m[string(MultaiBalancerDataLeader)] = spotinst.BoolValue(runtime.Leader)
+			m[string(MultaiBalancerDataReadiness)] = readiness
+			m[string(MultaiBalancerDataHealthiness)] = healthiness
+			m[string(MultaiBalancerDataLastReportedAt)] = lastReportedAt
+			runtimes = append(runtimes, m)
+
+			if ip != "" {
+				runtimeIps = append(runtimeIps, ip)
+				if spotinst.BoolValue(runtime.Leader) {
+					leaderIp = ip
+				}
+			}
+		}
+	}
+
+	if err := resourceData.Set(string(MultaiBalancerDataRuntimes), runtimes); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiBalancerDataRuntimes), err)
+	}
+	if err := resourceData.Set(string(MultaiBalancerDataRuntimeIps), runtimeIps); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiBalancerDataRuntimeIps), err)
+	}
+	if err := resourceData.Set(string(MultaiBalancerDataLeaderIp), leaderIp); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiBalancerDataLeaderIp), err)
+	}
+
+	resourceData.SetId(balancerId)
+	return nil
+}
+
+// findMultaiBalancerDeployment returns the ID of the deployment serving the
+// balancer, or "" when it is not part of any. Target sets carry their
+// deployment, so a single listing usually finds it. Otherwise every deployment
+// is searched, a request each, which setting deployment_id avoids.
+func findMultaiBalancerDeployment(client *Client, balancerId string) (string, error) {
+	targetSets, err := client.multai.ListTargetSets(context.Background(), &multai.ListTargetSetsInput{
+		BalancerID: spotinst.String(balancerId),
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Failed to list the target sets of balancer %s: %s", balancerId, err)
+	}
+	for _, targetSet := range targetSets.TargetSets {
+		if spotinst.StringValue(targetSet.BalancerID) == balancerId && spotinst.StringValue(targetSet.DeploymentID) != "" {
+			return spotinst.StringValue(targetSet.DeploymentID), nil
+		}
+	}
+
+	resp, err := client.multai.ListDeployments(context.Background(), &multai.ListDeploymentsInput{})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Failed to list deployments: %s", err)
+	}
+
+	for _, deployment := range resp.Deployments {
+		deploymentId := spotinst.StringValue(deployment.ID)
+		balancers, err := client.multai.ListLoadBalancers(context.Background(), &multai.ListLoadBalancersInput{
+			DeploymentID: spotinst.String(deploymentId),
+		})
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Failed to list the balancers of deployment %s: %s", deploymentId, err)
+		}
+		for _, balancer := range balancers.Balancers {
+			if spotinst.StringValue(balancer.ID) == balancerId {
+				return deploymentId, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// multaiStatus returns the readiness and healthiness of a status as the
+// names of the SDK enums. Missing or unrecognized healthiness is UNKNOWN.
+func multaiStatus(status *multai.Status) (string, string) {
+	readiness, healthiness := "", multai.StatusUnknown.String()
+	if status == nil {
+		return readiness, healthiness
+	}
+	if value, ok := multai.ReadinessStatusValue[strings.ToUpper(spotinst.StringValue(status.Readiness))]; ok {
+		readiness = value.String()
+	}
+	if value, ok := multai.HealthinessStatusValue[strings.ToUpper(spotinst.StringValue(status.Healthiness))]; ok {
+		healthiness = value.String()
+	}
+	return readiness, healthiness
+}
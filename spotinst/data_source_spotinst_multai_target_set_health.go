@@ -0,0 +1,200 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	TargetSetHealthTargetSetId      commons.FieldName = "target_set_id"
+	TargetSetHealthBalancerId       commons.FieldName = "balancer_id"
+	TargetSetHealthTargets          commons.FieldName = "targets"
+	TargetSetHealthTargetId         commons.FieldName = "target_id"
+	TargetSetHealthName             commons.FieldName = "name"
+	TargetSetHealthHost             commons.FieldName = "host"
+	TargetSetHealthPort             commons.FieldName = "port"
+	TargetSetHealthWeight           commons.FieldName = "weight"
+	TargetSetHealthReadiness        commons.FieldName = "readiness"
+	TargetSetHealthHealthiness      commons.FieldName = "healthiness"
+	TargetSetHealthHealthyTargets   commons.FieldName = "healthy_targets"
+	TargetSetHealthUnhealthyTargets commons.FieldName = "unhealthy_targets"
+	TargetSetHealthHealthyCount     commons.FieldName = "healthy_count"
+	TargetSetHealthUnhealthyCount   commons.FieldName = "unhealthy_count"
+	TargetSetHealthUnknownCount     commons.FieldName = "unknown_count"
+	TargetSetHealthAllHealthy       commons.FieldName = "all_healthy"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Data Source
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func dataSourceSpotinstMultaiTargetSetHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiTargetSetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			string(TargetSetHealthTargetSetId): {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			string(TargetSetHealthBalancerId): {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			string(TargetSetHealthTargets): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(TargetSetHealthTargetId): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(TargetSetHealthName): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(TargetSetHealthHost): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(TargetSetHealthPort): {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						string(TargetSetHealthWeight): {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						string(TargetSetHealthReadiness): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(TargetSetHealthHealthiness): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			string(TargetSetHealthHealthyTargets): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(TargetSetHealthUnhealthyTargets): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			string(TargetSetHealthHealthyCount): {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			string(TargetSetHealthUnhealthyCount): {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			string(TargetSetHealthUnknownCount): {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			string(TargetSetHealthAllHealthy): {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiTargetSetHealthRead(resourceData *schema.ResourceData, meta interface{}) error {
+	targetSetId := resourceData.Get(string(TargetSetHealthTargetSetId)).(string)
+	log.Printf("===> Reading the health of target set: %s <===", targetSetId)
+
+	input := &multai.ListTargetsInput{TargetSetID: spotinst.String(targetSetId)}
+	if v, ok := resourceData.GetOk(string(TargetSetHealthBalancerId)); ok {
+		input.BalancerID = spotinst.String(v.(string))
+	}
+
+	resp, err := meta.(*Client).multai.ListTargets(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to list the targets of target set %s: %s", targetSetId, err)
+	}
+	targets := resp.Targets
+
+	sort.Slice(targets, func(i, j int) bool {
+		return spotinst.StringValue(targets[i].ID) < spotinst.StringValue(targets[j].ID)
+	})
+
+	result := make([]interface{}, 0, len(targets))
+	healthyTargets := make([]string, 0)
+	unhealthyTargets := make([]string, 0)
+	unknown := 0
+	for _, target := range targets {
+		readiness, healthiness := multaiStatus(target.Status)
+		address := net.JoinHostPort(spotinst.StringValue(target.Host), strconv.Itoa(spotinst.IntValue(target.Port)))
+
+		m := make(map[string]interface{})
+		m[string(TargetSetHealthTargetId)] = spotinst.StringValue(target.ID)
+		m[string(TargetSetHealthName)] = spotinst.StringValue(target.Name)
+		m[string(TargetSetHealthHost)] = spotinst.StringValue(target.Host)
+		m[string(TargetSetHealthPort)] = spotinst.IntValue(target.Port)
+		m[string(TargetSetHealthWeight)] = spotinst.IntValue(target.Weight)
+		m[string(TargetSetHealthReadiness)] = readiness
+		m[string(TargetSetHealthHealthiness)] = healthiness
+		result = append(result, m)
+
+		switch healthiness {
+		case multai.StatusHealthy.String():
+			healthyTargets = append(healthyTargets, address)
+		case multai.StatusUnhealthy.String():
+			unhealthyTargets = append(unhealthyTargets, address)
+		default:
+			unknown++
+		}
+	}
+
+	if err := resourceData.Set(string(TargetSetHealthTargets), result); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TargetSetHealthTargets), err)
+	}
+	for fieldName, value := range map[commons.FieldName]interface{}{
+		TargetSetHealthHealthyTargets:   healthyTargets,
+		TargetSetHealthUnhealthyTargets: unhealthyTargets,
+		TargetSetHealthHealthyCount:     len(healthyTargets),
+		TargetSetHealthUnhealthyCount:   len(unhealthyTargets),
+		TargetSetHealthUnknownCount:     unknown,
+		TargetSetHealthAllHealthy:       len(targets) > 0 && len(healthyTargets) == len(targets),
+	} {
+		if err := resourceData.Set(string(fieldName), value); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+		}
+	}
+
+	resourceData.SetId(targetSetId)
+	return nil
+}
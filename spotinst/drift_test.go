@@ -0,0 +1,106 @@
+package spotinst
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestDriftReportingElastigroupAWS(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678", testExportElastigroupAWS)
+
+	client := api.Client(t)
+	client.driftReporting = commons.DriftReportingSummary
+
+	// Prior state as left by the last apply: the console since changed
+	// the desired capacity from 3 to 2 and the description.
+	state := &terraform.InstanceState{
+		ID: "sig-12345678",
+		Attributes: map[string]string{
+			"id":               "sig-12345678",
+			"name":             "web servers",
+			"description":      "managed by terraform",
+			"desired_capacity": "3",
+			"min_size":         "1",
+			"max_size":         "4",
+		},
+	}
+
+	res := resourceSpotinstElastigroupAws()
+	resourceData := res.Data(state)
+	if err := res.Read(resourceData, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	drifts := make(map[string][2]string)
+	for _, item := range resourceData.Get(string(commons.DriftSummary)).([]interface{}) {
+		m := item.(map[string]interface{})
+		drifts[m["field"].(string)] = [2]string{m["old_value"].(string), m["new_value"].(string)}
+	}
+
+	if got := drifts["desired_capacity"]; got != [2]string{"3", "2"} {
+		t.Fatalf("expected desired_capacity drift 3 -> 2, got %v (all: %v)", got, drifts)
+	}
+	if got := drifts["description"]; got != [2]string{"managed by terraform", "exported group"} {
+		t.Fatalf("expected description drift, got %v (all: %v)", got, drifts)
+	}
+	if _, ok := drifts["max_size"]; ok {
+		t.Fatalf("unexpected max_size drift: %v", drifts)
+	}
+}
+
+func TestDriftReportingDisabled(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678", testExportElastigroupAWS)
+
+	state := &terraform.InstanceState{
+		ID: "sig-12345678",
+		Attributes: map[string]string{
+			"id":               "sig-12345678",
+			"desired_capacity": "3",
+		},
+	}
+
+	res := resourceSpotinstElastigroupAws()
+	resourceData := res.Data(state)
+	if err := res.Read(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary := resourceData.Get(string(commons.DriftSummary)).([]interface{}); len(summary) != 0 {
+		t.Fatalf("expected no drift summary when disabled, got %v", summary)
+	}
+}
+
+func TestDriftReportingClearsStaleSummary(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678", testExportElastigroupAWS)
+
+	client := api.Client(t)
+	client.driftReporting = commons.DriftReportingSummary
+
+	// No previous values to compare with, only the summary of an older refresh.
+	state := &terraform.InstanceState{
+		ID: "sig-12345678",
+		Attributes: map[string]string{
+			"id":                        "sig-12345678",
+			"drift_summary.#":           "1",
+			"drift_summary.0.field":     "description",
+			"drift_summary.0.old_value": "managed by terraform",
+			"drift_summary.0.new_value": "exported group",
+		},
+	}
+
+	res := resourceSpotinstElastigroupAws()
+	resourceData := res.Data(state)
+	if err := res.Read(resourceData, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary := resourceData.Get(string(commons.DriftSummary)).([]interface{}); len(summary) != 0 {
+		t.Fatalf("expected the stale drift summary to be cleared, got %v", summary)
+	}
+}
@@ -0,0 +1,94 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestEBSVolumePoolElastigroupAWS(t *testing.T) {
+	response := strings.Replace(testExportElastigroupAWS,
+		`"strategy": {"risk": 100, "fallbackToOd": true},`,
+		`"strategy": {"risk": 100, "fallbackToOd": true, "spinUpTime": 300},`, 1)
+	response = strings.Replace(response,
+		`"product": "Linux/UNIX",`,
+		`"product": "Linux/UNIX", "ebsVolumePool": [{"deviceName": "/dev/xvdb", "volumeIds": ["vol-1", "vol-2"]}],`, 1)
+
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678", response)
+
+	res := resourceSpotinstElastigroupAws()
+	resourceData := res.Data(&terraform.InstanceState{ID: "sig-12345678"})
+	if err := res.Read(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := resourceData.Get("spin_up_time").(int); got != 300 {
+		t.Fatalf("expected spin_up_time 300, got %d", got)
+	}
+	pool := resourceData.Get("ebs_volume_pool").(*schema.Set).List()
+	if len(pool) != 1 {
+		t.Fatalf("expected a single ebs_volume_pool entry, got %d", len(pool))
+	}
+	volume := pool[0].(map[string]interface{})
+	if volume["device_name"] != "/dev/xvdb" || len(volume["volume_ids"].([]interface{})) != 2 {
+		t.Fatalf("unexpected ebs_volume_pool entry: %v", volume)
+	}
+}
+
+func TestEBSVolumePoolDeviceNameCollision(t *testing.T) {
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":                    "pool",
+		"product":                 "Linux/UNIX",
+		"fallback_to_ondemand":    true,
+		"instance_types_ondemand": "m5.large",
+		"instance_types_spot":     []interface{}{"m5.large"},
+		"security_groups":         []interface{}{"sg-123"},
+		"ebs_block_device": []interface{}{
+			map[string]interface{}{"device_name": "/dev/xvdb", "volume_size": 10},
+		},
+		"ebs_volume_pool": []interface{}{
+			map[string]interface{}{"device_name": "/dev/xvdb", "volume_ids": []interface{}{"vol-1"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res := resourceSpotinstElastigroupAws()
+	_, err = res.Diff(nil, terraform.NewResourceConfig(rawConfig), nil)
+	if err == nil || !strings.Contains(err.Error(), "already used by ebs_block_device") {
+		t.Fatalf("expected the plan to fail on the device name collision, got %v", err)
+	}
+}
+
+func TestEBSVolumePoolDeviceNameCollisionOnUpdate(t *testing.T) {
+	// The pool is unchanged, only the block device moves onto its device name.
+	pool := []interface{}{
+		map[string]interface{}{"device_name": "/dev/xvdb", "volume_ids": []interface{}{"vol-1"}},
+	}
+	res := resourceSpotinstElastigroupAws()
+	prior := res.Data(&terraform.InstanceState{ID: "sig-12345678"})
+	prior.Set("ebs_volume_pool", pool)
+	prior.Set("ebs_block_device", []interface{}{
+		map[string]interface{}{"device_name": "/dev/xvdc", "volume_size": 10},
+	})
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"ebs_volume_pool": pool,
+		"ebs_block_device": []interface{}{
+			map[string]interface{}{"device_name": "/dev/xvdb", "volume_size": 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = res.Diff(prior.State(), terraform.NewResourceConfig(rawConfig), nil)
+	if err == nil || !strings.Contains(err.Error(), "already used by ebs_block_device") {
+		t.Fatalf("expected the plan to fail on the device name collision, got %v", err)
+	}
+}
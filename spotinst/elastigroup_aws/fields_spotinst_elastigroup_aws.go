@@ -133,6 +133,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MaxSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -172,6 +175,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MinSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -210,6 +216,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, DesiredCapacity, commons.GetCapacityManagementMode(resourceData).ManagesDesiredCapacity()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -1106,6 +1115,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[commons.CapacityManagement] = commons.NewCapacityManagementField(commons.ElastigroupAWS)
+
+	fieldsMap[commons.DriftSummary] = commons.NewDriftSummaryField(commons.ElastigroupAWS)
 }
 
 var TargetGroupArnRegex = regexp.MustCompile(`arn:aws:elasticloadbalancing:.*:\d{12}:targetgroup/(.*)/.*`)
@@ -27,4 +27,9 @@ const (
 	EphemeralBlockDevice commons.FieldName = "ephemeral_block_device"
 	VirtualName          commons.FieldName = "virtual_name"
 	// --------------------------------------------------------------
+
+	// - EBS VOLUME POOL --------------------------------------------
+	EbsVolumePool commons.FieldName = "ebs_volume_pool"
+	VolumeIds     commons.FieldName = "volume_ids"
+	// --------------------------------------------------------------
 )
@@ -185,6 +185,74 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[EbsVolumePool] = commons.NewGenericField(
+		commons.ElastigroupAWSBlockDevices,
+		EbsVolumePool,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(DeviceName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(VolumeIds): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Required: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []interface{} = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.EBSVolumePool != nil {
+				value = flattenAWSGroupEBSVolumePool(elastigroup.Compute.EBSVolumePool)
+			}
+			if value != nil {
+				if err := resourceData.Set(string(EbsVolumePool), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EbsVolumePool), err)
+				}
+			} else {
+				if err := resourceData.Set(string(EbsVolumePool), []*aws.EBSVolume{}); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EbsVolumePool), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(EbsVolumePool)); ok {
+				if volumes, err := expandAWSGroupEBSVolumePool(v); err != nil {
+					return err
+				} else {
+					elastigroup.Compute.SetEBSVolumePool(volumes)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []*aws.EBSVolume = nil
+			if v, ok := resourceData.GetOk(string(EbsVolumePool)); ok {
+				if volumes, err := expandAWSGroupEBSVolumePool(v); err != nil {
+					return err
+				} else {
+					value = volumes
+				}
+			}
+			elastigroup.Compute.SetEBSVolumePool(value)
+			return nil
+		},
+		nil,
+	)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -382,3 +450,70 @@ func onUpdateBlockDevice(egWrapper *commons.ElastigroupWrapper, resourceData *sc
 	}
 	return nil
 }
+
+func flattenAWSGroupEBSVolumePool(volumes []*aws.EBSVolume) []interface{} {
+	result := make([]interface{}, 0, len(volumes))
+	for _, volume := range volumes {
+		m := make(map[string]interface{})
+		m[string(DeviceName)] = spotinst.StringValue(volume.DeviceName)
+		m[string(VolumeIds)] = volume.VolumeIDs
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandAWSGroupEBSVolumePool(data interface{}) ([]*aws.EBSVolume, error) {
+	list := data.(*schema.Set).List()
+	volumes := make([]*aws.EBSVolume, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		volume := &aws.EBSVolume{}
+
+		if v, ok := m[string(DeviceName)].(string); ok && v != "" {
+			volume.SetDeviceName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(VolumeIds)].([]interface{}); ok {
+			ids := make([]string, 0, len(v))
+			for _, id := range v {
+				if id, ok := id.(string); ok && id != "" {
+					ids = append(ids, id)
+				}
+			}
+			volume.SetVolumeIDs(ids)
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// ValidateEBSVolumePool makes sure a pooled volume is not attached to a
+// device name that is already mapped by an ebs_block_device. It runs on the
+// diff, so a collision fails the plan.
+func ValidateEBSVolumePool(diff *schema.ResourceDiff, meta interface{}) error {
+	devices := make(map[string]bool)
+	if v, ok := diff.GetOk(string(EbsBlockDevice)); ok {
+		for _, item := range v.(*schema.Set).List() {
+			m := item.(map[string]interface{})
+			if name, ok := m[string(DeviceName)].(string); ok && name != "" {
+				devices[name] = true
+			}
+		}
+	}
+
+	pooled := make(map[string]bool)
+	if v, ok := diff.GetOk(string(EbsVolumePool)); ok {
+		for _, item := range v.(*schema.Set).List() {
+			m := item.(map[string]interface{})
+			name, _ := m[string(DeviceName)].(string)
+			if devices[name] {
+				return fmt.Errorf("[ERROR] %s device name %q is already used by %s", string(EbsVolumePool), name, string(EbsBlockDevice))
+			}
+			if pooled[name] {
+				return fmt.Errorf("[ERROR] %s device name %q is declared more than once", string(EbsVolumePool), name)
+			}
+			pooled[name] = true
+		}
+	}
+	return nil
+}
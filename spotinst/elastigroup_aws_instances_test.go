@@ -0,0 +1,75 @@
+package spotinst
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestElastigroupAWSInstancesDataSource(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678/status",
+		`{"instanceId": "i-spot", "spotInstanceRequestId": "sir-1", "instanceType": "m5.large", "availabilityZone": "us-west-2a", "privateIp": "10.0.0.1", "publicIp": "54.0.0.1", "status": "fulfilled"}`,
+		`{"instanceId": "i-od", "instanceType": "m4.large", "availabilityZone": "us-west-2b", "privateIp": "10.0.0.2", "status": "running"}`,
+		`{"spotInstanceRequestId": "sir-2", "status": "pending-evaluation"}`)
+	api.Handle("GET", "/aws/ec2/group/sig-12345678/instanceHealthiness",
+		`{"instanceId": "i-spot", "lifeCycle": "SPOT", "healthStatus": "HEALTHY"}`,
+		`{"instanceId": "i-od", "lifeCycle": "OD", "healthStatus": "UNHEALTHY"}`)
+
+	cases := []struct {
+		lifecycle    string
+		healthStatus string
+		ids          []string
+		privateIps   int
+		publicIps    int
+	}{
+		{ids: []string{"i-spot", "i-od"}, privateIps: 2, publicIps: 1},
+		{lifecycle: "od", ids: []string{"i-od"}, privateIps: 1},
+		{healthStatus: "healthy", ids: []string{"i-spot"}, privateIps: 1, publicIps: 1},
+		{lifecycle: "od", healthStatus: "HEALTHY"},
+	}
+
+	for _, c := range cases {
+		source := dataSourceSpotinstElastigroupAwsInstances()
+		resourceData := schema.TestResourceDataRaw(t, source.Schema, map[string]interface{}{
+			"group_id":           "sig-12345678",
+			"instance_lifecycle": c.lifecycle,
+			"health_status":      c.healthStatus,
+		})
+		if err := source.Read(resourceData, api.Client(t)); err != nil {
+			t.Fatalf("%s/%s: unexpected error: %v", c.lifecycle, c.healthStatus, err)
+		}
+
+		ids := resourceData.Get("instance_ids").([]interface{})
+		if len(ids) != len(c.ids) {
+			t.Fatalf("%s/%s: expected instances %v, got %v", c.lifecycle, c.healthStatus, c.ids, ids)
+		}
+		for i, id := range c.ids {
+			if ids[i] != id {
+				t.Fatalf("%s/%s: expected instances %v, got %v", c.lifecycle, c.healthStatus, c.ids, ids)
+			}
+		}
+		if got := len(resourceData.Get("private_ips").([]interface{})); got != c.privateIps {
+			t.Fatalf("%s/%s: expected %d private IPs, got %d", c.lifecycle, c.healthStatus, c.privateIps, got)
+		}
+		if got := len(resourceData.Get("public_ips").([]interface{})); got != c.publicIps {
+			t.Fatalf("%s/%s: expected %d public IPs, got %d", c.lifecycle, c.healthStatus, c.publicIps, got)
+		}
+	}
+
+	source := dataSourceSpotinstElastigroupAwsInstances()
+	resourceData := schema.TestResourceDataRaw(t, source.Schema, map[string]interface{}{"group_id": "sig-12345678"})
+	if err := source.Read(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resourceData.Get("instances.0.lifecycle").(string); got != "SPOT" {
+		t.Fatalf("expected lifecycle SPOT, got %q", got)
+	}
+	if got := resourceData.Get("instances.1.health_status").(string); got != "UNHEALTHY" {
+		t.Fatalf("expected health_status UNHEALTHY, got %q", got)
+	}
+	if got := resourceData.Get("instances.0.public_ip").(string); got != "54.0.0.1" {
+		t.Fatalf("expected public_ip 54.0.0.1, got %q", got)
+	}
+}
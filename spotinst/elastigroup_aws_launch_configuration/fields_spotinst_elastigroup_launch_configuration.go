@@ -1,9 +1,6 @@
 package elastigroup_aws_launch_configuration
 
 import (
-	"crypto/sha1"
-	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 	"regexp"
 
@@ -205,35 +202,19 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.ElastigroupAWSLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: HexStateFunc,
-		},
+		commons.NewUserDataSchema(commons.UserDataLimitAWS),
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.HasUserDataParts(resourceData) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.UserData != nil {
-
-				userData := elastigroup.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-					value = string(decodedUserData)
-				}
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = elastigroup.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), HexStateFunc(value)); err != nil {
+			if err := resourceData.Set(string(UserData), commons.UserDataStateFromAPI(value)); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
 			}
 			return nil
@@ -242,17 +223,20 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+				userData := spotinst.String(commons.EncodeUserData(v))
 				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.HasUserDataParts(resourceData) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var userData *string = nil
 			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData = spotinst.String(base64Encode(v))
+				userData = spotinst.String(commons.EncodeUserData(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			return nil
@@ -260,38 +244,36 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[commons.UserDataPartField] = commons.NewUserDataPartsField(
+		commons.ElastigroupAWSLaunchConfiguration,
+		UserData,
+		commons.UserDataLimitAWS,
+		func(resourceObject interface{}) *string {
+			elastigroup := resourceObject.(*commons.ElastigroupWrapper).GetElastigroup()
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				return elastigroup.Compute.LaunchSpecification.UserData
+			}
+			return nil
+		},
+		func(resourceObject interface{}, value *string) {
+			elastigroup := resourceObject.(*commons.ElastigroupWrapper).GetElastigroup()
+			elastigroup.Compute.LaunchSpecification.SetUserData(value)
+		},
+	)
+
 	fieldsMap[ShutdownScript] = commons.NewGenericField(
 		commons.ElastigroupAWSLaunchConfiguration,
 		ShutdownScript,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: HexStateFunc,
-		},
+		commons.NewUserDataSchema(commons.UserDataLimitAWS),
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.ShutdownScript != nil {
-
-				shutdownScript := elastigroup.Compute.LaunchSpecification.ShutdownScript
-				shutdownScriptValue := spotinst.StringValue(shutdownScript)
-				if shutdownScriptValue != "" {
-					decodedShutdownScript, _ := base64.StdEncoding.DecodeString(shutdownScriptValue)
-					value = string(decodedShutdownScript)
-				}
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = elastigroup.Compute.LaunchSpecification.ShutdownScript
 			}
-			if err := resourceData.Set(string(ShutdownScript), HexStateFunc(value)); err != nil {
+			if err := resourceData.Set(string(ShutdownScript), commons.UserDataStateFromAPI(value)); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ShutdownScript), err)
 			}
 			return nil
@@ -300,7 +282,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript := spotinst.String(base64Encode(v))
+				shutdownScript := spotinst.String(commons.EncodeUserData(v))
 				elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			}
 			return nil
@@ -310,7 +292,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var shutdownScript *string = nil
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript = spotinst.String(base64Encode(v))
+				shutdownScript = spotinst.String(commons.EncodeUserData(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			return nil
@@ -493,30 +475,3 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 //            Utils
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
-
-func HexStateFunc(v interface{}) string {
-	switch s := v.(type) {
-	case string:
-		hash := sha1.Sum([]byte(s))
-		return hex.EncodeToString(hash[:])
-	default:
-		return ""
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
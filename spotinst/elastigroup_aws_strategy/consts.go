@@ -14,4 +14,5 @@ const (
 	DrainingTimeout          commons.FieldName = "draining_timeout"
 	UtilizeReservedInstances commons.FieldName = "utilize_reserved_instances"
 	FallbackToOnDemand       commons.FieldName = "fallback_to_ondemand"
+	SpinUpTime               commons.FieldName = "spin_up_time"
 )
@@ -293,6 +293,46 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[SpinUpTime] = commons.NewGenericField(
+		commons.ElastigroupAWSStrategy,
+		SpinUpTime,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *int = nil
+			if elastigroup.Strategy != nil && elastigroup.Strategy.SpinUpTime != nil {
+				value = elastigroup.Strategy.SpinUpTime
+			}
+			if err := resourceData.Set(string(SpinUpTime), spotinst.IntValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SpinUpTime), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.Get(string(SpinUpTime)).(int); ok && v > 0 {
+				elastigroup.Strategy.SetSpinUpTime(spotinst.Int(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var spinUpTime *int = nil
+			if v, ok := resourceData.Get(string(SpinUpTime)).(int); ok && v > 0 {
+				spinUpTime = spotinst.Int(v)
+			}
+			elastigroup.Strategy.SetSpinUpTime(spinUpTime)
+			return nil
+		},
+		nil,
+	)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
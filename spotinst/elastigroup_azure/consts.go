@@ -23,4 +23,7 @@ const (
 	BatchSizePercentage commons.FieldName = "batch_size_percentage"
 	GracePeriod         commons.FieldName = "grace_period"
 	HealthCheckType     commons.FieldName = "health_check_type"
+	WaitForRollPct      commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout  commons.FieldName = "wait_for_roll_timeout"
+	StopRollOnTimeout   commons.FieldName = "stop_roll_on_timeout"
 )
@@ -156,6 +156,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MinSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -195,6 +198,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MaxSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -233,6 +239,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, DesiredCapacity, commons.GetCapacityManagementMode(resourceData).ManagesDesiredCapacity()) {
+				return nil
+			}
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
@@ -301,6 +310,21 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeString,
 									Optional: true,
 								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+
+								string(StopRollOnTimeout): {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
 							},
 						},
 					},
@@ -310,4 +334,5 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil, nil, nil, nil,
 	)
 
+	fieldsMap[commons.CapacityManagement] = commons.NewCapacityManagementField(commons.ElastigroupAzure)
 }
@@ -0,0 +1,12 @@
+package elastigroup_azure_integrations
+
+import "github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	// - RANCHER -------------------------
+	IntegrationRancher commons.FieldName = "integration_rancher"
+	MasterHost         commons.FieldName = "master_host"
+	AccessKey          commons.FieldName = "access_key"
+	SecretKey          commons.FieldName = "secret_key"
+	// -----------------------------------
+)
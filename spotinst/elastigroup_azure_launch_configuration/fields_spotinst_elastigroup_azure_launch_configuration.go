@@ -1,9 +1,6 @@
 package elastigroup_azure_launch_configuration
 
 import (
-	"crypto/sha1"
-	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -17,34 +14,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.ElastigroupAzureLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// check to make sure nil SHA isn't being passed from somewhere upstream
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: HexStateFunc,
-		},
+		commons.NewUserDataSchema(commons.UserDataLimitAzure),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.UserData != nil {
-
-				userData := elastigroup.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-					value = string(decodedUserData)
-				}
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = elastigroup.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), HexStateFunc(value)); err != nil {
+			if err := resourceData.Set(string(UserData), commons.UserDataStateFromAPI(value)); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
 			}
 			return nil
@@ -53,7 +31,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+				userData := spotinst.String(commons.EncodeUserData(v))
 				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
@@ -66,34 +44,3 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 }
-
-//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
-//            Utils
-//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
-
-func HexStateFunc(v interface{}) string {
-	switch s := v.(type) {
-	case string:
-		hash := sha1.Sum([]byte(s))
-		return hex.EncodeToString(hash[:])
-	default:
-		return ""
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
@@ -0,0 +1,28 @@
+package elastigroup_azure_scaling_policies
+
+import "github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	ScalingUpPolicy   commons.FieldName = "scaling_up_policy"
+	ScalingDownPolicy commons.FieldName = "scaling_down_policy"
+
+	PolicyName commons.FieldName = "policy_name"
+	MetricName commons.FieldName = "metric_name"
+	Namespace  commons.FieldName = "namespace"
+	Statistic  commons.FieldName = "statistic"
+	Unit       commons.FieldName = "unit"
+	Cooldown   commons.FieldName = "cooldown"
+	Dimensions commons.FieldName = "dimensions"
+
+	Threshold         commons.FieldName = "threshold"
+	Operator          commons.FieldName = "operator"
+	EvaluationPeriods commons.FieldName = "evaluation_periods"
+	Period            commons.FieldName = "period"
+	ActionType        commons.FieldName = "action_type"
+	Adjustment        commons.FieldName = "adjustment"
+	MinTargetCapacity commons.FieldName = "min_target_capacity"
+	MaxTargetCapacity commons.FieldName = "max_target_capacity"
+	Minimum           commons.FieldName = "minimum"
+	Maximum           commons.FieldName = "maximum"
+	Target            commons.FieldName = "target"
+)
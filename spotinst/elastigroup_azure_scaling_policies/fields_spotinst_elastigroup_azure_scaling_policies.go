@@ -0,0 +1,368 @@
+package elastigroup_azure_scaling_policies
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Setup
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[ScalingUpPolicy] = commons.NewGenericField(
+		commons.ElastigroupAzureScalingPolicies,
+		ScalingUpPolicy,
+		scalingPolicySchema(),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var policiesResult []interface{} = nil
+			if elastigroup.Scaling != nil && elastigroup.Scaling.Up != nil {
+				policiesResult = flattenAzureGroupScalingPolicy(elastigroup.Scaling.Up)
+			}
+			if err := resourceData.Set(string(ScalingUpPolicy), policiesResult); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingUpPolicy), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok {
+				if policies, err := expandAzureGroupScalingPolicies(v); err != nil {
+					return err
+				} else {
+					elastigroup.Scaling.SetUp(policies)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []*azure.ScalingPolicy = nil
+			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok && v != nil {
+				if policies, err := expandAzureGroupScalingPolicies(v); err != nil {
+					return err
+				} else {
+					value = policies
+				}
+			}
+			if value != nil && len(value) > 0 {
+				elastigroup.Scaling.SetUp(value)
+			} else {
+				elastigroup.Scaling.SetUp(nil)
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ScalingDownPolicy] = commons.NewGenericField(
+		commons.ElastigroupAzureScalingPolicies,
+		ScalingDownPolicy,
+		scalingPolicySchema(),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var policiesResult []interface{} = nil
+			if elastigroup.Scaling != nil && elastigroup.Scaling.Down != nil {
+				policiesResult = flattenAzureGroupScalingPolicy(elastigroup.Scaling.Down)
+			}
+			if err := resourceData.Set(string(ScalingDownPolicy), policiesResult); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingDownPolicy), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok {
+				if policies, err := expandAzureGroupScalingPolicies(v); err != nil {
+					return err
+				} else {
+					elastigroup.Scaling.SetDown(policies)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []*azure.ScalingPolicy = nil
+			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok && v != nil {
+				if policies, err := expandAzureGroupScalingPolicies(v); err != nil {
+					return err
+				} else {
+					value = policies
+				}
+			}
+			if value != nil && len(value) > 0 {
+				elastigroup.Scaling.SetDown(value)
+			} else {
+				elastigroup.Scaling.SetDown(nil)
+			}
+			return nil
+		},
+		nil,
+	)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//             Schema
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func scalingPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(PolicyName): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				string(MetricName): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				string(Namespace): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				string(Statistic): {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(Unit): {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(Cooldown): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(Dimensions): {
+					Type:     schema.TypeMap,
+					Optional: true,
+				},
+
+				string(Threshold): {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+
+				string(Operator): {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(EvaluationPeriods): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(Period): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+
+				string(ActionType): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(Adjustment): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(MinTargetCapacity): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(MaxTargetCapacity): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(Minimum): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(Maximum): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				string(Target): {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//             Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func expandAzureGroupScalingPolicies(data interface{}) ([]*azure.ScalingPolicy, error) {
+	list := data.(*schema.Set).List()
+	policies := make([]*azure.ScalingPolicy, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		policy := &azure.ScalingPolicy{}
+
+		if v, ok := m[string(PolicyName)].(string); ok && v != "" {
+			policy.SetPolicyName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(MetricName)].(string); ok && v != "" {
+			policy.SetMetricName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Namespace)].(string); ok && v != "" {
+			policy.SetNamespace(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Statistic)].(string); ok && v != "" {
+			policy.SetStatistic(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Unit)].(string); ok && v != "" {
+			policy.SetUnit(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Threshold)].(float64); ok && v > 0 {
+			policy.SetThreshold(spotinst.Float64(v))
+		}
+
+		if v, ok := m[string(Operator)].(string); ok && v != "" {
+			policy.SetOperator(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Period)].(int); ok && v > 0 {
+			policy.SetPeriod(spotinst.Int(v))
+		}
+
+		if v, ok := m[string(EvaluationPeriods)].(int); ok && v > 0 {
+			policy.SetEvaluationPeriods(spotinst.Int(v))
+		}
+
+		if v, ok := m[string(Cooldown)].(int); ok && v > 0 {
+			policy.SetCooldown(spotinst.Int(v))
+		}
+
+		if v, ok := m[string(Dimensions)]; ok {
+			dimensions := expandAzureGroupScalingPolicyDimensions(v.(map[string]interface{}))
+			if len(dimensions) > 0 {
+				policy.SetDimensions(dimensions)
+			}
+		}
+
+		if v, ok := m[string(ActionType)].(string); ok && v != "" {
+			action := &azure.Action{}
+			action.SetType(spotinst.String(v))
+
+			if v, ok := m[string(Adjustment)].(string); ok && v != "" {
+				action.SetAdjustment(spotinst.String(v))
+			}
+
+			if v, ok := m[string(MinTargetCapacity)].(string); ok && v != "" {
+				action.SetMinTargetCapacity(spotinst.String(v))
+			}
+
+			if v, ok := m[string(MaxTargetCapacity)].(string); ok && v != "" {
+				action.SetMaxTargetCapacity(spotinst.String(v))
+			}
+
+			if v, ok := m[string(Minimum)].(string); ok && v != "" {
+				action.SetMinimum(spotinst.String(v))
+			}
+
+			if v, ok := m[string(Maximum)].(string); ok && v != "" {
+				action.SetMaximum(spotinst.String(v))
+			}
+
+			if v, ok := m[string(Target)].(string); ok && v != "" {
+				action.SetTarget(spotinst.String(v))
+			}
+
+			policy.SetAction(action)
+		}
+
+		if policy.Namespace != nil {
+			policies = append(policies, policy)
+		}
+	}
+
+	return policies, nil
+}
+
+func expandAzureGroupScalingPolicyDimensions(list map[string]interface{}) []*azure.Dimension {
+	dimensions := make([]*azure.Dimension, 0, len(list))
+	for name, val := range list {
+		dimension := &azure.Dimension{}
+		dimension.SetName(spotinst.String(name))
+		dimension.SetValue(spotinst.String(val.(string)))
+		dimensions = append(dimensions, dimension)
+	}
+	return dimensions
+}
+
+func flattenAzureGroupScalingPolicy(policies []*azure.ScalingPolicy) []interface{} {
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		m := make(map[string]interface{})
+		m[string(PolicyName)] = spotinst.StringValue(policy.PolicyName)
+		m[string(MetricName)] = spotinst.StringValue(policy.MetricName)
+		m[string(Namespace)] = spotinst.StringValue(policy.Namespace)
+		m[string(Statistic)] = spotinst.StringValue(policy.Statistic)
+		m[string(Unit)] = spotinst.StringValue(policy.Unit)
+		m[string(Cooldown)] = spotinst.IntValue(policy.Cooldown)
+		m[string(Threshold)] = spotinst.Float64Value(policy.Threshold)
+		m[string(Operator)] = spotinst.StringValue(policy.Operator)
+		m[string(EvaluationPeriods)] = spotinst.IntValue(policy.EvaluationPeriods)
+		m[string(Period)] = spotinst.IntValue(policy.Period)
+
+		if policy.Dimensions != nil && len(policy.Dimensions) > 0 {
+			dimMap := make(map[string]interface{})
+			for _, dimension := range policy.Dimensions {
+				dimMap[spotinst.StringValue(dimension.Name)] = spotinst.StringValue(dimension.Value)
+			}
+			m[string(Dimensions)] = dimMap
+		}
+
+		if policy.Action != nil && policy.Action.Type != nil {
+			m[string(ActionType)] = spotinst.StringValue(policy.Action.Type)
+			m[string(Adjustment)] = spotinst.StringValue(policy.Action.Adjustment)
+			m[string(MinTargetCapacity)] = spotinst.StringValue(policy.Action.MinTargetCapacity)
+			m[string(MaxTargetCapacity)] = spotinst.StringValue(policy.Action.MaxTargetCapacity)
+			m[string(Minimum)] = spotinst.StringValue(policy.Action.Minimum)
+			m[string(Maximum)] = spotinst.StringValue(policy.Action.Maximum)
+			m[string(Target)] = spotinst.StringValue(policy.Action.Target)
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
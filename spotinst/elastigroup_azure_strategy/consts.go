@@ -11,4 +11,8 @@ const (
 	LowPriorityPercentage commons.FieldName = "low_priority_percentage"
 	OnDemandCount         commons.FieldName = "od_count"
 	DrainingTimeout       commons.FieldName = "draining_timeout"
+
+	Signal        commons.FieldName = "signal"
+	SignalName    commons.FieldName = "name"
+	SignalTimeout commons.FieldName = "timeout"
 )
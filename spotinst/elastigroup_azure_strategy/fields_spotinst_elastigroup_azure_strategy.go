@@ -54,10 +54,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Strategy)); ok {
-				if strategy, err := expandAzureGroupStrategy(v); err != nil {
+				if elastigroup.Strategy == nil {
+					elastigroup.SetStrategy(&azure.Strategy{})
+				}
+				if err := expandAzureGroupStrategy(v, elastigroup.Strategy); err != nil {
 					return err
-				} else {
-					elastigroup.SetStrategy(strategy)
 				}
 			}
 			return nil
@@ -66,14 +67,79 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Strategy)); ok {
-				if strategy, err := expandAzureGroupStrategy(v); err != nil {
+				if elastigroup.Strategy == nil {
+					elastigroup.SetStrategy(&azure.Strategy{})
+				}
+				if err := expandAzureGroupStrategy(v, elastigroup.Strategy); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Signal] = commons.NewGenericField(
+		commons.ElastigroupAzureStrategy,
+		Signal,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(SignalName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(SignalTimeout): {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []interface{} = nil
+			if elastigroup.Strategy != nil && elastigroup.Strategy.Signals != nil {
+				value = flattenAzureGroupSignals(elastigroup.Strategy.Signals)
+			}
+			if err := resourceData.Set(string(Signal), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Signal), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(Signal)); ok {
+				if signals, err := expandAzureGroupSignals(v); err != nil {
 					return err
 				} else {
-					elastigroup.SetStrategy(strategy)
+					elastigroup.Strategy.SetSignals(signals)
 				}
 			}
 			return nil
 		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value []*azure.Signal = nil
+			if v, ok := resourceData.GetOk(string(Signal)); ok {
+				if signals, err := expandAzureGroupSignals(v); err != nil {
+					return err
+				} else {
+					value = signals
+				}
+			}
+			if elastigroup.Strategy == nil {
+				elastigroup.SetStrategy(&azure.Strategy{})
+			}
+			elastigroup.Strategy.SetSignals(value)
+			return nil
+		},
 		nil,
 	)
 }
@@ -89,8 +155,7 @@ func flattenAzureGroupStrategy(strategy *azure.Strategy) []interface{} {
 	return []interface{}{result}
 }
 
-func expandAzureGroupStrategy(data interface{}) (*azure.Strategy, error) {
-	strategy := &azure.Strategy{}
+func expandAzureGroupStrategy(data interface{}, strategy *azure.Strategy) error {
 	list := data.([]interface{})
 	if list != nil && list[0] != nil {
 		m := list[0].(map[string]interface{})
@@ -107,5 +172,35 @@ func expandAzureGroupStrategy(data interface{}) (*azure.Strategy, error) {
 			strategy.SetDrainingTimeout(spotinst.Int(v))
 		}
 	}
-	return strategy, nil
+	return nil
+}
+
+func flattenAzureGroupSignals(signals []*azure.Signal) []interface{} {
+	result := make([]interface{}, 0, len(signals))
+	for _, signal := range signals {
+		m := make(map[string]interface{})
+		m[string(SignalName)] = spotinst.StringValue(signal.Name)
+		m[string(SignalTimeout)] = spotinst.IntValue(signal.Timeout)
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandAzureGroupSignals(data interface{}) ([]*azure.Signal, error) {
+	list := data.(*schema.Set).List()
+	signals := make([]*azure.Signal, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		signal := &azure.Signal{}
+
+		if v, ok := m[string(SignalName)].(string); ok && v != "" {
+			signal.SetName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(SignalTimeout)].(int); ok && v > 0 {
+			signal.SetTimeout(spotinst.Int(v))
+		}
+		signals = append(signals, signal)
+	}
+	return signals, nil
 }
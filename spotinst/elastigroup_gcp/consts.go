@@ -14,4 +14,18 @@ const (
 	Subnets                commons.FieldName = "subnets"
 	Region                 commons.FieldName = "region"
 	SubnetNames            commons.FieldName = "subnet_names"
+
+	// ***********************************************************************
+	// ********************* Spotinst Unique Properties **********************
+	// ***********************************************************************
+
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig          commons.FieldName = "roll_config"
+	BatchSizePercentage commons.FieldName = "batch_size_percentage"
+	GracePeriod         commons.FieldName = "grace_period"
+	HealthCheckType     commons.FieldName = "health_check_type"
+	WaitForRollPct      commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout  commons.FieldName = "wait_for_roll_timeout"
 )
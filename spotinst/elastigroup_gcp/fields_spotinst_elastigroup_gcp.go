@@ -23,7 +23,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Deprecated: "This field will soon be handled by Region in Subnets",
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []string
 			if elastigroup.Compute != nil && elastigroup.Compute.AvailabilityZones != nil {
@@ -38,7 +38,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(AvailabilityZones)); ok {
 				zonesList := v.([]interface{})
@@ -51,7 +51,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(AvailabilityZones)); ok {
 				zonesList := v.([]interface{})
@@ -74,7 +74,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *string = nil
 			if elastigroup.Description != nil {
@@ -86,13 +86,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			elastigroup.SetDescription(spotinst.String(resourceData.Get(string(Description)).(string)))
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			elastigroup.SetDescription(spotinst.String(resourceData.Get(string(Description)).(string)))
 			return nil
@@ -108,7 +108,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.Health != nil &&
@@ -122,7 +122,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOkExists(string(HealthCheckGracePeriod)); ok && v != nil {
 				if elastigroup.Compute.Health == nil {
@@ -133,7 +133,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
 			if v, ok := resourceData.GetOkExists(string(HealthCheckGracePeriod)); ok && v != nil {
@@ -157,7 +157,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			if !commons.ShouldReadCapacity(resourceData, MaxSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
 			if elastigroup.Capacity != nil && elastigroup.Capacity.Maximum != nil {
@@ -169,7 +172,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(MaxSize)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetMaximum(spotinst.Int(v))
@@ -177,7 +180,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(MaxSize)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetMaximum(spotinst.Int(v))
@@ -196,7 +199,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			if !commons.ShouldReadCapacity(resourceData, MinSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
 			if elastigroup.Capacity != nil && elastigroup.Capacity.Minimum != nil {
@@ -208,7 +214,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(MinSize)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetMinimum(spotinst.Int(v))
@@ -216,7 +222,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(MinSize)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetMinimum(spotinst.Int(v))
@@ -234,7 +240,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *string = nil
 			if elastigroup.Name != nil {
@@ -246,14 +252,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			elastigroup.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
 
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			elastigroup.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
 
@@ -287,7 +293,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Subnets)); ok {
 				if subnets, err := expandSubnets(v); err != nil {
@@ -299,7 +305,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var subnetList []*gcp.Subnet = nil
 			if value, ok := resourceData.GetOk(string(Subnets)); ok {
@@ -323,7 +329,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			if !commons.ShouldReadCapacity(resourceData, TargetCapacity, commons.GetCapacityManagementMode(resourceData).ManagesDesiredCapacity()) {
+				return nil
+			}
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *int = nil
 			if elastigroup.Capacity != nil && elastigroup.Capacity.Target != nil {
@@ -335,7 +344,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(TargetCapacity)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetTarget(spotinst.Int(v))
@@ -343,7 +352,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(TargetCapacity)).(int); ok && v >= 0 {
 				elastigroup.Capacity.SetTarget(spotinst.Int(v))
@@ -353,6 +362,61 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[UpdatePolicy] = commons.NewGenericField(
+		commons.ElastigroupGCP,
+		UpdatePolicy,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldRoll): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+
+					string(RollConfig): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(BatchSizePercentage): {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+
+								string(GracePeriod): {
+									Type:     schema.TypeInt,
+									Optional: true,
+									Default:  -1,
+								},
+
+								string(HealthCheckType): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[commons.CapacityManagement] = commons.NewCapacityManagementField(commons.ElastigroupGCP)
 }
 
 // expandSubnets expands the list of subnet objects
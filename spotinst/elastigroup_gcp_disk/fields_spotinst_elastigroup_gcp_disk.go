@@ -84,7 +84,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Disk)); ok {
 				if networks, err := expandDisks(v); err != nil {
@@ -96,7 +96,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Disk)); ok {
 				if networks, err := expandDisks(v); err != nil {
@@ -36,7 +36,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(GPU)); ok {
 				if gpu, err := expandGPU(v); err != nil {
@@ -48,7 +48,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result *gcp.GPU = nil
 			if v, ok := resourceData.GetOk(string(GPU)); ok {
@@ -21,7 +21,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *string = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.InstanceTypes != nil &&
@@ -34,7 +34,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(OnDemand)).(string); ok && v != "" {
 				elastigroup.Compute.InstanceTypes.SetOnDemand(spotinst.String(v))
@@ -42,7 +42,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(OnDemand)).(string); ok && v != "" {
 				elastigroup.Compute.InstanceTypes.SetOnDemand(spotinst.String(v))
@@ -61,7 +61,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []string
 			if elastigroup.Compute != nil && elastigroup.Compute.InstanceTypes != nil &&
@@ -77,7 +77,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Preemptible)); ok {
 				prempts := v.([]interface{})
@@ -90,7 +90,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Preemptible)); ok {
 				prempts := v.([]interface{})
@@ -126,7 +126,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []interface{} = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.InstanceTypes != nil &&
@@ -142,7 +142,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Custom)); ok {
 				if customInstances, err := expandCustom(v); err != nil {
@@ -154,7 +154,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(Custom)); ok {
 				if customInstances, err := expandCustom(v); err != nil {
@@ -7,4 +7,5 @@ import "github.com/terraform-providers/terraform-provider-spotinst/spotinst/comm
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	SetupDockerSwarm(fieldsMap)
+	SetupGKE(fieldsMap)
 }
@@ -38,7 +38,7 @@ func SetupDockerSwarm(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(IntegrationDockerSwarm)); ok {
 				if integration, err := expandGCPGroupDockerSwarmIntegration(v); err != nil {
@@ -51,7 +51,7 @@ func SetupDockerSwarm(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *gcp.DockerSwarmIntegration = nil
 
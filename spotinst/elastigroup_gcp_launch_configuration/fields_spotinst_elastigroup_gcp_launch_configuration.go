@@ -2,9 +2,6 @@ package elastigroup_gcp_launch_configuration
 
 import (
 	"bytes"
-	"crypto/sha1"
-	"encoding/base64"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -68,7 +65,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(BackendServices)); ok {
 				if services, err := expandServices(v); err != nil {
@@ -82,7 +79,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result *gcp.BackendServiceConfig = nil
 			if v, ok := resourceData.GetOk(string(BackendServices)); ok {
@@ -123,7 +120,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Set: hashKV,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []interface{} = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
@@ -139,7 +136,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if value, ok := resourceData.GetOk(string(Labels)); ok {
 				if labels, err := expandLabels(value); err != nil {
@@ -151,7 +148,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var labelList []*gcp.Label = nil
 			if value, ok := resourceData.GetOk(string(Labels)); ok {
@@ -189,7 +186,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Set: hashKV,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []interface{} = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
@@ -205,7 +202,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if value, ok := resourceData.GetOk(string(Metadata)); ok {
 				if metadata, err := expandMetadata(value); err != nil {
@@ -217,7 +214,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var metadataList []*gcp.Metadata
 			if value, ok := resourceData.GetOk(string(Metadata)); ok {
@@ -242,7 +239,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value []string = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
@@ -256,7 +253,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []string
 			if v, ok := resourceData.GetOk(string(Tags)); ok && v != nil {
@@ -270,7 +267,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var result []string
 			if v, ok := resourceData.GetOk(string(Tags)); ok && v != nil {
@@ -289,53 +286,34 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[StartupScript] = commons.NewGenericField(
 		commons.ElastigroupAWSLaunchConfiguration,
 		StartupScript,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			// occasionally startup_script will be set to the hash value of a null string
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: HexStateFunc,
-		},
+		commons.NewUserDataSchema(commons.UserDataLimitGCP),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.StartupScript != nil {
-
-				startupScript := elastigroup.Compute.LaunchSpecification.StartupScript
-				startupScriptValue := spotinst.StringValue(startupScript)
-				if startupScriptValue != "" {
-					decodedStartupScript, _ := base64.StdEncoding.DecodeString(startupScriptValue)
-					value = string(decodedStartupScript)
-				}
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = elastigroup.Compute.LaunchSpecification.StartupScript
 			}
-			if err := resourceData.Set(string(StartupScript), HexStateFunc(value)); err != nil {
+			if err := resourceData.Set(string(StartupScript), commons.UserDataStateFromAPI(value)); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(StartupScript), err)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(StartupScript)).(string); ok && v != "" {
-				startupScript := spotinst.String(base64Encode(v))
+				startupScript := spotinst.String(commons.EncodeUserData(v))
 				elastigroup.Compute.LaunchSpecification.SetStartupScript(startupScript)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var startupScript *string = nil
 			if v, ok := resourceData.Get(string(StartupScript)).(string); ok && v != "" {
-				startupScript = spotinst.String(base64Encode(v))
+				startupScript = spotinst.String(commons.EncodeUserData(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetStartupScript(startupScript)
 			return nil
@@ -351,7 +329,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *string = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
@@ -364,7 +342,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(ServiceAccount)).(string); ok && v != "" {
 				elastigroup.Compute.LaunchSpecification.SetServiceAccount(spotinst.String(v))
@@ -372,7 +350,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			serviceAccount := ""
 			if v, ok := resourceData.Get(string(ServiceAccount)).(string); ok && v != "" {
@@ -393,7 +371,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Default:  false,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value *bool = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
@@ -406,7 +384,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(IPForwarding)).(bool); ok {
 				elastigroup.Compute.LaunchSpecification.SetIPForwarding(spotinst.Bool(v))
@@ -414,7 +392,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(IPForwarding)).(bool); ok {
 				elastigroup.Compute.LaunchSpecification.SetIPForwarding(spotinst.Bool(v))
@@ -439,33 +417,6 @@ func hashKV(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func HexStateFunc(v interface{}) string {
-	switch s := v.(type) {
-	case string:
-		hash := sha1.Sum([]byte(s))
-		return hex.EncodeToString(hash[:])
-	default:
-		return ""
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //         Flatten Fields
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -18,7 +18,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		ScalingUpPolicy,
 		upDownScalingPolicySchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Up != nil {
@@ -31,7 +31,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok {
 				if policies, err := expandGCPGroupScalingPolicies(v); err != nil {
@@ -43,7 +43,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value []*gcp.ScalingPolicy = nil
 			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok && v != nil {
@@ -68,7 +68,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		ScalingDownPolicy,
 		upDownScalingPolicySchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Down != nil {
@@ -81,7 +81,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok {
 				if policies, err := expandGCPGroupScalingPolicies(v); err != nil {
@@ -93,7 +93,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
+			egWrapper := resourceObject.(commons.GCPGroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value []*gcp.ScalingPolicy = nil
 			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok && v != nil {
@@ -0,0 +1,289 @@
+package spotinst
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Types
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// Exporter renders existing Spotinst objects as Terraform configuration.
+// Objects are read through the same Read functions (and therefore the same
+// onRead flatteners) used by the provider, and the resulting state is rendered
+// by walking the resource schema.
+type Exporter struct {
+	provider *schema.Provider
+	client   *Client
+}
+
+// ExportedResource holds the rendered configuration of a single object.
+type ExportedResource struct {
+	Type  string
+	Label string
+	ID    string
+	HCL   string
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//          Constructors
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func NewExporter(client *Client) *Exporter {
+	return &Exporter{
+		provider: Provider().(*schema.Provider),
+		client:   client,
+	}
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//       Methods: Exporter
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// ResourceTypes returns the names of all resources that can be exported.
+func (e *Exporter) ResourceTypes() []string {
+	types := make([]string, 0, len(e.provider.ResourcesMap))
+	for name := range e.provider.ResourcesMap {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Export reads the object identified by id and renders it as a resource block.
+// The id is resolved through the resource importer, so every form accepted by
+// `terraform import` (e.g. names or composite IDs) is accepted here as well.
+func (e *Exporter) Export(resourceType string, id string) (*ExportedResource, error) {
+	res, ok := e.provider.ResourcesMap[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] unknown resource type [%v]", resourceType)
+	}
+
+	resourceData := res.Data(nil)
+	resourceData.SetId(id)
+
+	if res.Importer != nil && res.Importer.State != nil {
+		states, err := res.Importer.State(resourceData, e.client)
+		if err != nil {
+			return nil, err
+		}
+		if len(states) != 1 {
+			return nil, fmt.Errorf("[ERROR] expected a single object for [%v], got %d", id, len(states))
+		}
+		resourceData = states[0]
+	}
+
+	log.Printf("onExport() -> %s -> reading %s...", resourceType, resourceData.Id())
+	if err := res.Read(resourceData, e.client); err != nil {
+		return nil, err
+	}
+	if resourceData.Id() == "" {
+		return nil, fmt.Errorf("[ERROR] %s [%v] does not exist", resourceType, id)
+	}
+
+	exported := &ExportedResource{
+		Type:  resourceType,
+		Label: exportLabel(resourceData),
+		ID:    resourceData.Id(),
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "resource %q %q {\n", exported.Type, exported.Label)
+	writeExportBody(&buf, res.Schema, func(key string) (interface{}, bool) {
+		value, ok := resourceData.GetOk(key)
+		return value, ok
+	}, 1)
+	buf.WriteString("}\n")
+	exported.HCL = buf.String()
+
+	return exported, nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//    Methods: ExportedResource
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// ImportCommand returns the `terraform import` command matching the rendered block.
+func (r *ExportedResource) ImportCommand() string {
+	return fmt.Sprintf("terraform import %s.%s %s", r.Type, r.Label, r.ID)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// exportLabel derives the resource label from the object name, falling back to its ID.
+func exportLabel(resourceData *schema.ResourceData) string {
+	label := resourceData.Id()
+	if name, ok := resourceData.GetOk("name"); ok {
+		if v, ok := name.(string); ok && v != "" {
+			label = v
+		}
+	}
+
+	label = strings.Trim(invalidLabelChars.ReplaceAllString(label, "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+	return label
+}
+
+// writeExportBody renders every configurable attribute in schemaMap, sorted by name.
+// Attributes that are only computed are skipped, as are unset optional attributes.
+func writeExportBody(buf *bytes.Buffer, schemaMap map[string]*schema.Schema, get func(key string) (interface{}, bool), depth int) {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	indent := strings.Repeat("  ", depth)
+	for _, key := range keys {
+		s := schemaMap[key]
+		if !s.Required && !s.Optional {
+			continue
+		}
+		if s.Deprecated != "" || s.Removed != "" {
+			continue
+		}
+
+		value, ok := get(key)
+		if !ok && !differsFromDefault(s, value) {
+			if s.Required {
+				fmt.Fprintf(buf, "%s# %s = (required, not returned by the API)\n", indent, key)
+			}
+			continue
+		}
+
+		if s.StateFunc != nil {
+			if str, isStr := value.(string); isStr && s.StateFunc(str) != str {
+				// An empty value may be stored as the hash of an empty string.
+				if str != s.StateFunc("") {
+					fmt.Fprintf(buf, "%s# %s = (stored as a hash, cannot be exported)\n", indent, key)
+				}
+				continue
+			}
+		}
+
+		writeExportAttribute(buf, key, s, value, depth)
+	}
+}
+
+func writeExportAttribute(buf *bytes.Buffer, key string, s *schema.Schema, value interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		items := exportListItems(value)
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, item := range items {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(buf, "%s%s {\n", indent, key)
+				writeExportBody(buf, elem.Schema, func(k string) (interface{}, bool) {
+					v, ok := m[k]
+					return v, ok && !isZeroExportValue(v)
+				}, depth+1)
+				fmt.Fprintf(buf, "%s}\n", indent)
+			}
+			return
+		}
+
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, exportPrimitive(item))
+		}
+		fmt.Fprintf(buf, "%s%s = [%s]\n", indent, key, strings.Join(values, ", "))
+
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		mapKeys := make([]string, 0, len(m))
+		for k := range m {
+			mapKeys = append(mapKeys, k)
+		}
+		sort.Strings(mapKeys)
+
+		fmt.Fprintf(buf, "%s%s = {\n", indent, key)
+		for _, k := range mapKeys {
+			fmt.Fprintf(buf, "%s  %s = %s\n", indent, exportString(k), exportPrimitive(m[k]))
+		}
+		fmt.Fprintf(buf, "%s}\n", indent)
+
+	default:
+		fmt.Fprintf(buf, "%s%s = %s\n", indent, key, exportPrimitive(value))
+	}
+}
+
+func exportListItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	default:
+		return nil
+	}
+}
+
+func exportPrimitive(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return exportString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return exportString(fmt.Sprintf("%v", v))
+	}
+}
+
+// exportString quotes a string, escaping interpolation sequences.
+func exportString(value string) string {
+	return strings.Replace(strconv.Quote(value), "${", "$${", -1)
+}
+
+// differsFromDefault reports whether a zero value must still be rendered because
+// it differs from the schema default, e.g. a `false` boolean defaulting to `true`.
+func differsFromDefault(s *schema.Schema, value interface{}) bool {
+	if s.Default == nil || value == nil {
+		return false
+	}
+	return fmt.Sprintf("%v", s.Default) != fmt.Sprintf("%v", value)
+}
+
+func isZeroExportValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,77 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+)
+
+const testExportElastigroupAWS = `{
+  "id": "sig-12345678",
+  "name": "web servers",
+  "description": "exported group",
+  "region": "us-west-2",
+  "capacity": {"minimum": 1, "maximum": 4, "target": 2, "unit": "instance"},
+  "strategy": {"risk": 100, "fallbackToOd": true},
+  "compute": {
+    "product": "Linux/UNIX",
+    "availabilityZones": [{"name": "us-west-2a", "subnetIds": ["subnet-123"]}],
+    "instanceTypes": {"ondemand": "m5.large", "spot": ["m5.large", "m4.large"]},
+    "launchSpecification": {
+      "imageId": "ami-12345678",
+      "securityGroupIds": ["sg-123"],
+      "userData": "ZWNobyBoZWxsbw==",
+      "tags": [{"tagKey": "env", "tagValue": "prod"}]
+    }
+  }
+}`
+
+func TestExportElastigroupAWS(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group/sig-12345678", testExportElastigroupAWS)
+	api.Handle("GET", "/aws/ec2/group", testExportElastigroupAWS)
+
+	exporter := NewExporter(api.Client(t))
+
+	for _, id := range []string{"sig-12345678", "web servers"} {
+		exported, err := exporter.Export("spotinst_elastigroup_aws", id)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", id, err)
+		}
+
+		expected := []string{
+			`resource "spotinst_elastigroup_aws" "web_servers" {`,
+			`  name = "web servers"`,
+			`  desired_capacity = 2`,
+			`  max_size = 4`,
+			`  instance_types_spot = ["m5.large", "m4.large"]`,
+			`  image_id = "ami-12345678"`,
+			`  tags {`,
+			`    key = "env"`,
+			`  # user_data = (stored as a hash, cannot be exported)`,
+		}
+		for _, line := range expected {
+			if !strings.Contains(exported.HCL, line+"\n") {
+				t.Fatalf("%s: expected line %q in:\n%s", id, line, exported.HCL)
+			}
+		}
+
+		if cmd := exported.ImportCommand(); cmd != "terraform import spotinst_elastigroup_aws.web_servers sig-12345678" {
+			t.Fatalf("%s: unexpected import command %q", id, cmd)
+		}
+	}
+}
+
+func TestExportUnknownObject(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/ec2/group")
+
+	exporter := NewExporter(api.Client(t))
+	if _, err := exporter.Export("spotinst_elastigroup_aws", "missing"); err == nil {
+		t.Fatalf("expected error for unknown group name")
+	}
+	if _, err := exporter.Export("spotinst_unknown", "sig-1"); err == nil {
+		t.Fatalf("expected error for unknown resource type")
+	}
+}
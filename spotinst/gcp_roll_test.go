@@ -0,0 +1,74 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestElastigroupGCPUpdateRollsGroup(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+
+	group := `{"id": "sig-gcp12345", "name": "gcp-roll", "capacity": {"minimum": 0, "maximum": 3, "target": 1}}`
+	api.Handle("PUT", "/gcp/gce/group/sig-gcp12345", group)
+	api.Handle("GET", "/gcp/gce/group/sig-gcp12345", group)
+	api.Handle("PUT", "/gcp/gce/group/sig-gcp12345/roll", `{"id": "sbgd-1234", "status": "STARTING", "progress": {"unit": "percent", "value": 0}}`)
+	api.Handle("GET", "/gcp/gce/group/sig-gcp12345/roll/sbgd-1234", `{"id": "sbgd-1234", "status": "IN_PROGRESS", "progress": {"unit": "percent", "value": 50}}`)
+
+	cases := []struct {
+		waitForRoll bool
+		polled      bool
+	}{
+		{waitForRoll: false, polled: false},
+		{waitForRoll: true, polled: true},
+	}
+
+	for _, c := range cases {
+		rollConfig := map[string]interface{}{
+			"batch_size_percentage": 33,
+			"grace_period":          300,
+			"health_check_type":     "TCP",
+		}
+		if c.waitForRoll {
+			rollConfig["wait_for_roll_percentage"] = 50
+			rollConfig["wait_for_roll_timeout"] = 30
+		}
+
+		res := resourceSpotinstElastigroupGCP()
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":             "gcp-roll",
+			"desired_capacity": 2,
+			"update_policy": []interface{}{
+				map[string]interface{}{
+					"should_roll": true,
+					"roll_config": []interface{}{rollConfig},
+				},
+			},
+		})
+		resourceData.SetId("sig-gcp12345")
+
+		before := len(api.Requests())
+		if err := res.Update(resourceData, api.Client(t)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		body := api.RequestBody("PUT", "/gcp/gce/group/sig-gcp12345/roll")
+		for _, expected := range []string{`"batchSizePercentage":33`, `"gracePeriod":300`, `"healthCheckType":"TCP"`} {
+			if !strings.Contains(body, expected) {
+				t.Fatalf("expected the roll request to contain %s, got %s", expected, body)
+			}
+		}
+
+		polled := false
+		for _, r := range api.Requests()[before:] {
+			if r == "GET /gcp/gce/group/sig-gcp12345/roll/sbgd-1234" {
+				polled = true
+			}
+		}
+		if polled != c.polled {
+			t.Fatalf("wait_for_roll=%v: expected deployment status polled=%v, got %v", c.waitForRoll, c.polled, polled)
+		}
+	}
+}
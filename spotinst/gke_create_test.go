@@ -0,0 +1,107 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestElastigroupGKECreateAppliesGCPFields(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+
+	group := `{"id": "sig-gke12345", "name": "gke-pool", "capacity": {"minimum": 0, "maximum": 3, "target": 1}}`
+	api.Handle("POST", "/gcp/gce/group/gke/import", group)
+	api.Handle("PUT", "/gcp/gce/group/sig-gke12345", group)
+	api.Handle("GET", "/gcp/gce/group/sig-gke12345", group)
+
+	res := resourceSpotinstElastigroupGKE()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":              "gke-pool",
+		"cluster_id":        "cluster-1",
+		"cluster_zone_name": "us-central1-a",
+		"desired_capacity":  1,
+		"service_account":   "nodes@project.iam.gserviceaccount.com",
+		"disk": []interface{}{
+			map[string]interface{}{
+				"type":        "SCRATCH",
+				"interface":   "NVME",
+				"auto_delete": true,
+			},
+		},
+	})
+
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resourceData.Id(); got != "sig-gke12345" {
+		t.Fatalf("expected ID sig-gke12345, got %q", got)
+	}
+
+	importBody := api.RequestBody("POST", "/gcp/gce/group/gke/import")
+	if strings.Contains(importBody, "SCRATCH") {
+		t.Fatalf("expected disks to be left out of the import request, got %s", importBody)
+	}
+
+	updateBody := api.RequestBody("PUT", "/gcp/gce/group/sig-gke12345")
+	for _, expected := range []string{`"type":"SCRATCH"`, `"interface":"NVME"`, `"serviceAccount":"nodes@project.iam.gserviceaccount.com"`} {
+		if !strings.Contains(updateBody, expected) {
+			t.Fatalf("expected the post-import update to contain %s, got %s", expected, updateBody)
+		}
+	}
+}
+
+func TestElastigroupGKEIntegrationAutoscaler(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+
+	group := `{"id": "sig-gke12345", "name": "gke-pool", "integration": {"gke": {"clusterID": "cluster-1", "clusterZoneName": "us-central1-a"}}}`
+	api.Handle("PUT", "/gcp/gce/group/sig-gke12345", group)
+	api.Handle("GET", "/gcp/gce/group/sig-gke12345", group)
+
+	// The cluster fields are immutable, so they are left out of the update.
+	res := resourceSpotinstElastigroupGKE()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":             "gke-pool",
+		"desired_capacity": 0,
+		"integration_gke": []interface{}{
+			map[string]interface{}{
+				"autoscale_is_enabled":     true,
+				"autoscale_is_auto_config": false,
+				"autoscale_cooldown":       300,
+				"autoscale_headroom": []interface{}{
+					map[string]interface{}{"cpu_per_unit": 1024, "memory_per_unit": 512, "num_of_units": 2},
+				},
+				"autoscale_down": []interface{}{
+					map[string]interface{}{"evaluation_periods": 3},
+				},
+				"autoscale_labels": []interface{}{
+					map[string]interface{}{"key": "pool", "value": "spot"},
+				},
+			},
+		},
+	})
+	resourceData.SetId("sig-gke12345")
+
+	if err := res.Update(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("PUT", "/gcp/gce/group/sig-gke12345")
+	for _, expected := range []string{
+		`"isEnabled":true`,
+		`"isAutoConfig":false`,
+		`"cooldown":300`,
+		`"headroom":{"cpuPerUnit":1024,"memoryPerUnit":512,"numOfUnits":2}`,
+		`"down":{"evaluationPeriods":3}`,
+		`"labels":[{"key":"pool","value":"spot"}]`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected the update to contain %s, got %s", expected, body)
+		}
+	}
+	if strings.Contains(body, `"gke":null`) {
+		t.Fatalf("expected the GKE integration to be kept, got %s", body)
+	}
+}
@@ -0,0 +1,181 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	ocean "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	ElastigroupIDPrefix = "sig-"
+	OceanIDPrefix       = "o-"
+	MRScalerIDPrefix    = "simrs-"
+
+	compositeIDSeparator = "/"
+)
+
+// lookupIDsByName returns the IDs of all objects whose name matches the given name.
+type lookupIDsByName func(name string, meta interface{}) ([]string, error)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//        Import By Name
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// importStateByName returns an import function which accepts either a Spotinst ID
+// (recognized by its prefix) or a unique object name. Names are resolved through the
+// List API of the relevant service.
+func importStateByName(resourceName commons.ResourceName, idPrefix string, lookup lookupIDsByName) schema.StateFunc {
+	return func(resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id := resourceData.Id()
+		if strings.HasPrefix(id, idPrefix) {
+			return []*schema.ResourceData{resourceData}, nil
+		}
+
+		log.Printf("onImport() -> %s -> resolving ID for name [%v]...", resourceName, id)
+		ids, err := lookup(id, meta)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] onImport() -> Failed to list %s objects: %v", resourceName, err)
+		}
+
+		switch len(ids) {
+		case 0:
+			return nil, fmt.Errorf("[ERROR] onImport() -> No %s found with ID or name [%v]", resourceName, id)
+		case 1:
+			log.Printf("onImport() -> %s -> name [%v] resolved to ID [%v]", resourceName, id, ids[0])
+			resourceData.SetId(ids[0])
+			return []*schema.ResourceData{resourceData}, nil
+		default:
+			return nil, fmt.Errorf("[ERROR] onImport() -> Name [%v] is not unique, found %d %s objects: %v",
+				id, len(ids), resourceName, strings.Join(ids, ", "))
+		}
+	}
+}
+
+func lookupElastigroupAWSByName(name string, meta interface{}) ([]string, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().List(context.Background(), &aws.ListGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, group := range resp.Groups {
+		if spotinst.StringValue(group.Name) == name {
+			ids = append(ids, spotinst.StringValue(group.ID))
+		}
+	}
+	return ids, nil
+}
+
+func lookupElastigroupGCPByName(name string, meta interface{}) ([]string, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().List(context.Background(), &gcp.ListGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, group := range resp.Groups {
+		if spotinst.StringValue(group.Name) == name {
+			ids = append(ids, spotinst.StringValue(group.ID))
+		}
+	}
+	return ids, nil
+}
+
+func lookupElastigroupAzureByName(name string, meta interface{}) ([]string, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderAzure().List(context.Background(), &azure.ListGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, group := range resp.Groups {
+		if spotinst.StringValue(group.Name) == name {
+			ids = append(ids, spotinst.StringValue(group.ID))
+		}
+	}
+	return ids, nil
+}
+
+func lookupOceanAWSByName(name string, meta interface{}) ([]string, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListClusters(context.Background(), &ocean.ListClustersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, cluster := range resp.Clusters {
+		if spotinst.StringValue(cluster.Name) == name {
+			ids = append(ids, spotinst.StringValue(cluster.ID))
+		}
+	}
+	return ids, nil
+}
+
+func lookupMRScalerAWSByName(name string, meta interface{}) ([]string, error) {
+	resp, err := meta.(*Client).mrscaler.List(context.Background(), &mrscaler.ListScalersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, scaler := range resp.Scalers {
+		if spotinst.StringValue(scaler.Name) == name {
+			ids = append(ids, spotinst.StringValue(scaler.ID))
+		}
+	}
+	return ids, nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//      Import By Composite ID
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// importStateByCompositeID returns an import function for child objects which accepts
+// either the object ID alone or "<parent_1>/.../<parent_n>/<id>". Each parent part is
+// stored in the matching field, in order, and the last part becomes the resource ID.
+func importStateByCompositeID(parentFields ...commons.FieldName) schema.StateFunc {
+	return func(resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id := resourceData.Id()
+		if !strings.Contains(id, compositeIDSeparator) {
+			return []*schema.ResourceData{resourceData}, nil
+		}
+
+		parts := strings.Split(id, compositeIDSeparator)
+		if len(parts) != len(parentFields)+1 {
+			return nil, fmt.Errorf("[ERROR] onImport() -> Unexpected format of ID [%v], expected %s", id, compositeIDFormat(parentFields))
+		}
+
+		for i, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("[ERROR] onImport() -> Unexpected format of ID [%v], expected %s", id, compositeIDFormat(parentFields))
+			}
+			if i < len(parentFields) {
+				if err := resourceData.Set(string(parentFields[i]), part); err != nil {
+					return nil, fmt.Errorf("[ERROR] onImport() -> Failed to set field [%v]: %v", parentFields[i], err)
+				}
+			}
+		}
+
+		resourceData.SetId(parts[len(parts)-1])
+		return []*schema.ResourceData{resourceData}, nil
+	}
+}
+
+func compositeIDFormat(parentFields []commons.FieldName) string {
+	parts := make([]string, 0, len(parentFields)+1)
+	for _, field := range parentFields {
+		parts = append(parts, string(field))
+	}
+	parts = append(parts, "id")
+	return strings.Join(parts, compositeIDSeparator)
+}
@@ -0,0 +1,97 @@
+package spotinst
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/multai_target"
+)
+
+func TestImportStateByCompositeID(t *testing.T) {
+	res := resourceSpotinstMultaiTarget()
+	importer := importStateByCompositeID(multai_target.BalancerID, multai_target.TargetSetID)
+
+	cases := []struct {
+		id          string
+		expectedID  string
+		balancerID  string
+		targetSetID string
+		expectErr   bool
+	}{
+		{id: "t-123", expectedID: "t-123"},
+		{id: "lb-1/ts-2/t-3", expectedID: "t-3", balancerID: "lb-1", targetSetID: "ts-2"},
+		{id: "lb-1/t-3", expectErr: true},
+		{id: "lb-1//t-3", expectErr: true},
+	}
+
+	for _, c := range cases {
+		resourceData := res.Data(nil)
+		resourceData.SetId(c.id)
+
+		states, err := importer(resourceData, nil)
+		if c.expectErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got none", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.id, err)
+		}
+		if len(states) != 1 {
+			t.Fatalf("%s: expected 1 state, got %d", c.id, len(states))
+		}
+		if got := states[0].Id(); got != c.expectedID {
+			t.Fatalf("%s: expected ID %q, got %q", c.id, c.expectedID, got)
+		}
+		if got := states[0].Get(string(multai_target.BalancerID)).(string); got != c.balancerID {
+			t.Fatalf("%s: expected balancer_id %q, got %q", c.id, c.balancerID, got)
+		}
+		if got := states[0].Get(string(multai_target.TargetSetID)).(string); got != c.targetSetID {
+			t.Fatalf("%s: expected target_set_id %q, got %q", c.id, c.targetSetID, got)
+		}
+	}
+}
+
+func TestImportStateByName(t *testing.T) {
+	groups := map[string][]string{
+		"unique":    {"sig-1"},
+		"duplicate": {"sig-2", "sig-3"},
+	}
+	lookup := func(name string, meta interface{}) ([]string, error) {
+		return groups[name], nil
+	}
+	importer := importStateByName(commons.ElastigroupAwsResourceName, ElastigroupIDPrefix, lookup)
+	res := &schema.Resource{Schema: map[string]*schema.Schema{}}
+
+	cases := []struct {
+		id         string
+		expectedID string
+		expectErr  bool
+	}{
+		{id: "sig-9", expectedID: "sig-9"},
+		{id: "unique", expectedID: "sig-1"},
+		{id: "duplicate", expectErr: true},
+		{id: "missing", expectErr: true},
+	}
+
+	for _, c := range cases {
+		resourceData := res.Data(nil)
+		resourceData.SetId(c.id)
+
+		states, err := importer(resourceData, nil)
+		if c.expectErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got none", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.id, err)
+		}
+		if got := states[0].Id(); got != c.expectedID {
+			t.Fatalf("%s: expected ID %q, got %q", c.id, c.expectedID, got)
+		}
+	}
+}
@@ -20,12 +20,32 @@ const (
 	ExposeClusterID   commons.FieldName = "expose_cluster_id"
 	OutputClusterID   commons.FieldName = "output_cluster_id"
 
+	WaitForCluster            commons.FieldName = "wait_for_cluster"
+	WaitForClusterTargetState commons.FieldName = "target_state"
+	WaitForClusterTimeout     commons.FieldName = "timeout"
+
+	ForceDestroy               commons.FieldName = "force_destroy"
+	DrainStepsTimeout          commons.FieldName = "drain_steps_timeout"
+	TerminatesClusterOnDestroy commons.FieldName = "terminates_cluster_on_destroy"
+
 	ConfigurationsFile   commons.FieldName = "configurations_file"
 	BootstrapActionsFile commons.FieldName = "bootstrap_actions_file"
 	StepsFile            commons.FieldName = "steps_file"
 	Bucket               commons.FieldName = "bucket"
 	Key                  commons.FieldName = "key"
 
+	Configurations      commons.FieldName = "configurations"
+	Classification      commons.FieldName = "classification"
+	Properties          commons.FieldName = "properties"
+	BootstrapActions    commons.FieldName = "bootstrap_actions"
+	ActionName          commons.FieldName = "name"
+	ActionPath          commons.FieldName = "path"
+	Steps               commons.FieldName = "steps"
+	StepName            commons.FieldName = "name"
+	StepActionOnFailure commons.FieldName = "action_on_failure"
+	StepJar             commons.FieldName = "jar"
+	StepMainClass       commons.FieldName = "main_class"
+
 	EBSRootVolumeSize           commons.FieldName = "ebs_root_volume_size"
 	ManagedPrimarySecurityGroup commons.FieldName = "managed_primary_security_group"
 	ManagedReplicaSecurityGroup commons.FieldName = "managed_replica_security_group"
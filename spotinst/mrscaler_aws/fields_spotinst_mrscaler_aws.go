@@ -214,6 +214,115 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[WaitForCluster] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		WaitForCluster,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(WaitForClusterTargetState): {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "RUNNING",
+						ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+							if value := v.(string); value != "RUNNING" && value != "WAITING" {
+								return nil, []error{fmt.Errorf("%q must be %q or %q, got %q", k, "RUNNING", "WAITING", value)}
+							}
+							return nil, nil
+						},
+					},
+
+					string(WaitForClusterTimeout): {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  1800,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ForceDestroy] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		ForceDestroy,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[DrainStepsTimeout] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		DrainStepsTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[TerminatesClusterOnDestroy] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		TerminatesClusterOnDestroy,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			// A wrapped cluster outlives its scaler, a cloned or new one is terminated with it.
+			if scaler.Strategy != nil {
+				value := scaler.Strategy.Wrapping == nil
+				if err := resourceData.Set(string(TerminatesClusterOnDestroy), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TerminatesClusterOnDestroy), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
 	fieldsMap[AvailabilityZones] = commons.NewGenericField(
 		commons.MRScalerAWS,
 		AvailabilityZones,
@@ -531,6 +640,195 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[Configurations] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		Configurations,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{string(ConfigurationsFile)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(Classification): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(Properties): {
+						Type:     schema.TypeMap,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Compute != nil && scaler.Compute.Configurations != nil &&
+				scaler.Compute.Configurations.Inline != nil {
+				result := flattenConfigurations(scaler.Compute.Configurations.Inline)
+				if err := resourceData.Set(string(Configurations), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Configurations), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if value, ok := resourceData.GetOk(string(Configurations)); ok {
+				if configurations, err := expandConfigurations(value); err != nil {
+					return err
+				} else {
+					scaler.Compute.SetConfigurations(&mrscaler.Configurations{Inline: configurations})
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(Configurations))
+			return err
+		},
+		nil,
+	)
+
+	fieldsMap[BootstrapActions] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		BootstrapActions,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{string(BootstrapActionsFile)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ActionName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(ActionPath): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(Args): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Compute != nil && scaler.Compute.BootstrapActions != nil &&
+				scaler.Compute.BootstrapActions.Inline != nil {
+				result := flattenBootstrapActions(scaler.Compute.BootstrapActions.Inline)
+				if err := resourceData.Set(string(BootstrapActions), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BootstrapActions), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if value, ok := resourceData.GetOk(string(BootstrapActions)); ok {
+				if actions, err := expandBootstrapActions(value); err != nil {
+					return err
+				} else {
+					scaler.Compute.SetBootstrapActions(&mrscaler.BootstrapActions{Inline: actions})
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(BootstrapActions))
+			return err
+		},
+		nil,
+	)
+
+	fieldsMap[Steps] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		Steps,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{string(StepsFile)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(StepName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(StepJar): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(StepMainClass): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(StepActionOnFailure): {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "CONTINUE",
+						ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+							switch v.(string) {
+							case "TERMINATE_CLUSTER", "CANCEL_AND_WAIT", "CONTINUE":
+								return nil, nil
+							}
+							return nil, []error{fmt.Errorf("%q must be one of TERMINATE_CLUSTER, CANCEL_AND_WAIT or CONTINUE, got %q", k, v)}
+						},
+					},
+
+					string(Args): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Compute != nil && scaler.Compute.Steps != nil &&
+				scaler.Compute.Steps.Inline != nil {
+				result := flattenSteps(scaler.Compute.Steps.Inline)
+				if err := resourceData.Set(string(Steps), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Steps), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if value, ok := resourceData.GetOk(string(Steps)); ok {
+				if steps, err := expandSteps(value); err != nil {
+					return err
+				} else {
+					scaler.Compute.SetSteps(&mrscaler.Steps{Inline: steps})
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(Steps))
+			return err
+		},
+		nil,
+	)
+
 	fieldsMap[EBSRootVolumeSize] = commons.NewGenericField(
 		commons.MRScalerAWS,
 		EBSRootVolumeSize,
@@ -887,6 +1185,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[commons.DriftSummary] = commons.NewDriftSummaryField(commons.MRScalerAWS)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -995,6 +1294,131 @@ func expandApplications(data interface{}) ([]*mrscaler.Application, error) {
 	return apps, nil
 }
 
+func expandConfigurations(data interface{}) ([]*mrscaler.Configuration, error) {
+	list := data.([]interface{})
+	configurations := make([]*mrscaler.Configuration, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		configuration := &mrscaler.Configuration{}
+
+		if v, ok := m[string(Classification)].(string); ok && v != "" {
+			configuration.SetClassification(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Properties)].(map[string]interface{}); ok && len(v) > 0 {
+			properties := make(map[string]string, len(v))
+			for key, value := range v {
+				properties[key] = value.(string)
+			}
+			configuration.SetProperties(properties)
+		}
+
+		configurations = append(configurations, configuration)
+	}
+	return configurations, nil
+}
+
+func expandBootstrapActions(data interface{}) ([]*mrscaler.BootstrapAction, error) {
+	list := data.([]interface{})
+	actions := make([]*mrscaler.BootstrapAction, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		action := &mrscaler.BootstrapAction{}
+
+		if v, ok := m[string(ActionName)].(string); ok && v != "" {
+			action.SetName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(ActionPath)].(string); ok && v != "" {
+			action.SetPath(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Args)]; ok {
+			if args, err := expandGenericList(v); err != nil {
+				return nil, err
+			} else if len(args) > 0 {
+				action.SetArgs(args)
+			}
+		}
+
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func expandSteps(data interface{}) ([]*mrscaler.Step, error) {
+	list := data.([]interface{})
+	steps := make([]*mrscaler.Step, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		step := &mrscaler.Step{}
+
+		if v, ok := m[string(StepName)].(string); ok && v != "" {
+			step.SetName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(StepJar)].(string); ok && v != "" {
+			step.SetJar(spotinst.String(v))
+		}
+
+		if v, ok := m[string(StepMainClass)].(string); ok && v != "" {
+			step.SetMainClass(spotinst.String(v))
+		}
+
+		if v, ok := m[string(StepActionOnFailure)].(string); ok && v != "" {
+			step.SetActionOnFailure(spotinst.String(v))
+		}
+
+		if v, ok := m[string(Args)]; ok {
+			if args, err := expandGenericList(v); err != nil {
+				return nil, err
+			} else if len(args) > 0 {
+				step.SetArgs(args)
+			}
+		}
+
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func flattenConfigurations(configurations []*mrscaler.Configuration) []interface{} {
+	result := make([]interface{}, 0, len(configurations))
+	for _, configuration := range configurations {
+		m := make(map[string]interface{})
+		m[string(Classification)] = spotinst.StringValue(configuration.Classification)
+		m[string(Properties)] = configuration.Properties
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenBootstrapActions(actions []*mrscaler.BootstrapAction) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		m := make(map[string]interface{})
+		m[string(ActionName)] = spotinst.StringValue(action.Name)
+		m[string(ActionPath)] = spotinst.StringValue(action.Path)
+		m[string(Args)] = action.Args
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenSteps(steps []*mrscaler.Step) []interface{} {
+	result := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		m := make(map[string]interface{})
+		m[string(StepName)] = spotinst.StringValue(step.Name)
+		m[string(StepJar)] = spotinst.StringValue(step.Jar)
+		m[string(StepMainClass)] = spotinst.StringValue(step.MainClass)
+		m[string(StepActionOnFailure)] = spotinst.StringValue(step.ActionOnFailure)
+		m[string(Args)] = step.Args
+		result = append(result, m)
+	}
+	return result
+}
+
 func flattenS3File(file *mrscaler.S3File) []interface{} {
 	m := make(map[string]interface{})
 	m[string(Bucket)] = spotinst.StringValue(file.Bucket)
@@ -11,6 +11,8 @@ const (
 	TaskEBSBlockDevice commons.FieldName = "task_ebs_block_device"
 	TaskEBSOptimized   commons.FieldName = "task_ebs_optimized"
 
+	TaskInstanceTypeConfig commons.FieldName = "task_instance_type_config"
+
 	MasterInstanceTypes  commons.FieldName = "master_instance_types"
 	MasterLifecycle      commons.FieldName = "master_lifecycle"
 	MasterEBSBlockDevice commons.FieldName = "master_ebs_block_device"
@@ -24,8 +26,14 @@ const (
 	CoreEBSBlockDevice commons.FieldName = "core_ebs_block_device"
 	CoreEBSOptimized   commons.FieldName = "core_ebs_optimized"
 
+	CoreInstanceTypeConfig commons.FieldName = "core_instance_type_config"
+
 	VolumesPerInstance commons.FieldName = "volumes_per_instance"
 	VolumeType         commons.FieldName = "volume_type"
 	SizeInGB           commons.FieldName = "size_in_gb"
 	IOPS               commons.FieldName = "iops"
+
+	InstanceType     commons.FieldName = "instance_type"
+	WeightedCapacity commons.FieldName = "weighted_capacity"
+	EBSBlockDevice   commons.FieldName = "ebs_block_device"
 )
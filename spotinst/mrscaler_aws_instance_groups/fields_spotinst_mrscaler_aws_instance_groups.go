@@ -1,6 +1,8 @@
 package mrscaler_aws_instance_groups
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -72,3 +74,114 @@ func flattenMRscalerEBSBlockDevices(devices []*mrscaler.BlockDeviceConfig) []int
 	}
 	return result
 }
+
+// instanceTypeConfigSchema describes a weighted instance type of a group,
+// optionally with its own EBS block devices.
+func instanceTypeConfigSchema(conflictsWith string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{conflictsWith},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(InstanceType): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				string(WeightedCapacity): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+					ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+						if v.(int) < 1 {
+							return nil, []error{fmt.Errorf("%q must be at least 1, got %d", k, v)}
+						}
+						return nil, nil
+					},
+				},
+
+				string(EBSBlockDevice): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     instanceTypeEBSBlockDeviceResource(),
+					Set:      schema.HashResource(instanceTypeEBSBlockDeviceResource()),
+				},
+			},
+		},
+	}
+}
+
+func instanceTypeEBSBlockDeviceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			string(VolumesPerInstance): {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			string(VolumeType): {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			string(SizeInGB): {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			string(IOPS): {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// expandInstanceTypeConfigs returns the weighted configs along with the plain
+// list of their instance types, which the group still reports.
+func expandInstanceTypeConfigs(data interface{}) ([]*mrscaler.InstanceTypeConfig, []string, error) {
+	list := data.([]interface{})
+	configs := make([]*mrscaler.InstanceTypeConfig, 0, len(list))
+	types := make([]string, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		config := &mrscaler.InstanceTypeConfig{}
+
+		if v, ok := m[string(InstanceType)].(string); ok && v != "" {
+			config.SetInstanceType(spotinst.String(v))
+			types = append(types, v)
+		}
+
+		if v, ok := m[string(WeightedCapacity)].(int); ok && v > 0 {
+			config.SetWeightedCapacity(spotinst.Int(v))
+		}
+
+		if v, ok := m[string(EBSBlockDevice)]; ok && v.(*schema.Set).Len() > 0 {
+			if devices, err := expandScalerAWSBlockDevices(v); err != nil {
+				return nil, nil, err
+			} else {
+				config.SetEBSConfiguration(&mrscaler.EBSConfiguration{BlockDeviceConfigs: devices})
+			}
+		}
+
+		configs = append(configs, config)
+	}
+	return configs, types, nil
+}
+
+func flattenInstanceTypeConfigs(configs []*mrscaler.InstanceTypeConfig) []interface{} {
+	result := make([]interface{}, 0, len(configs))
+	for _, config := range configs {
+		m := make(map[string]interface{})
+		m[string(InstanceType)] = spotinst.StringValue(config.InstanceType)
+		m[string(WeightedCapacity)] = spotinst.IntValue(config.WeightedCapacity)
+		if config.EBSConfiguration != nil && config.EBSConfiguration.BlockDeviceConfigs != nil {
+			// Nested sets can't be set from a slice, so hash the devices here.
+			m[string(EBSBlockDevice)] = schema.NewSet(schema.HashResource(instanceTypeEBSBlockDeviceResource()),
+				flattenMRscalerEBSBlockDevices(config.EBSConfiguration.BlockDeviceConfigs))
+		}
+		result = append(result, m)
+	}
+	return result
+}
@@ -221,6 +221,18 @@ func SetupCoreGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var result []string
+			// Weighted groups report their types through core_instance_type_config.
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup.InstanceTypeConfigs == nil {
+				result = scaler.Compute.InstanceGroups.CoreGroup.InstanceTypes
+			}
+			if err := resourceData.Set(string(CoreInstanceTypes), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CoreInstanceTypes), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -251,6 +263,17 @@ func SetupCoreGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup.EBSConfiguration != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup.EBSConfiguration.Optimized != nil {
+				value := scaler.Compute.InstanceGroups.CoreGroup.EBSConfiguration.Optimized
+				if err := resourceData.Set(string(CoreEBSOptimized), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CoreEBSOptimized), err)
+				}
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -376,4 +399,47 @@ func SetupCoreGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[CoreInstanceTypeConfig] = commons.NewGenericField(
+		commons.MRScalerAWSCoreGroup,
+		CoreInstanceTypeConfig,
+		instanceTypeConfigSchema(string(CoreInstanceTypes)),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var result []interface{}
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup != nil &&
+				scaler.Compute.InstanceGroups.CoreGroup.InstanceTypeConfigs != nil {
+				result = flattenInstanceTypeConfigs(scaler.Compute.InstanceGroups.CoreGroup.InstanceTypeConfigs)
+			}
+			if err := resourceData.Set(string(CoreInstanceTypeConfig), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CoreInstanceTypeConfig), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if v, ok := resourceData.GetOk(string(CoreInstanceTypeConfig)); ok {
+				if scaler.Compute.InstanceGroups.CoreGroup == nil {
+					scaler.Compute.InstanceGroups.SetCoreGroup(&mrscaler.InstanceGroup{})
+				}
+
+				if configs, types, err := expandInstanceTypeConfigs(v); err != nil {
+					return err
+				} else {
+					scaler.Compute.InstanceGroups.CoreGroup.SetInstanceTypeConfigs(configs)
+					scaler.Compute.InstanceGroups.CoreGroup.SetInstanceTypes(types)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(CoreInstanceTypeConfig))
+			return err
+		},
+		nil,
+	)
 }
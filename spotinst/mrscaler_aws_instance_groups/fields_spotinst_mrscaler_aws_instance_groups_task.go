@@ -20,6 +20,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var value *int = nil
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity.Minimum != nil {
+				value = scaler.Compute.InstanceGroups.TaskGroup.Capacity.Minimum
+			}
+			if err := resourceData.Set(string(TaskMin), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskMin), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -55,6 +67,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var value *int = nil
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity.Maximum != nil {
+				value = scaler.Compute.InstanceGroups.TaskGroup.Capacity.Maximum
+			}
+			if err := resourceData.Set(string(TaskMax), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskMax), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -90,6 +114,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var value *int = nil
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.Capacity.Target != nil {
+				value = scaler.Compute.InstanceGroups.TaskGroup.Capacity.Target
+			}
+			if err := resourceData.Set(string(TaskTarget), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskTarget), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -125,6 +161,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var value *string = nil
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil && scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.LifeCycle != nil {
+				value = scaler.Compute.InstanceGroups.TaskGroup.LifeCycle
+			}
+			if value != nil {
+				if err := resourceData.Set(string(TaskLifecycle), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskLifecycle), err)
+				}
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -152,6 +200,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var result []string
+			// Weighted groups report their types through task_instance_type_config.
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.InstanceTypeConfigs == nil {
+				result = scaler.Compute.InstanceGroups.TaskGroup.InstanceTypes
+			}
+			if err := resourceData.Set(string(TaskInstanceTypes), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskInstanceTypes), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -180,6 +240,17 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Default:  false,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration.Optimized != nil {
+				value := scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration.Optimized
+				if err := resourceData.Set(string(TaskEBSOptimized), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskEBSOptimized), err)
+				}
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -248,6 +319,18 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var result []interface{}
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration.BlockDeviceConfigs != nil {
+				result = flattenMRscalerEBSBlockDevices(scaler.Compute.InstanceGroups.TaskGroup.EBSConfiguration.BlockDeviceConfigs)
+			}
+			if err := resourceData.Set(string(TaskEBSBlockDevice), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskEBSBlockDevice), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -294,4 +377,47 @@ func SetupTaskGroup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[TaskInstanceTypeConfig] = commons.NewGenericField(
+		commons.MRScalerAWSTaskGroup,
+		TaskInstanceTypeConfig,
+		instanceTypeConfigSchema(string(TaskInstanceTypes)),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var result []interface{}
+			if scaler.Compute != nil && scaler.Compute.InstanceGroups != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup != nil &&
+				scaler.Compute.InstanceGroups.TaskGroup.InstanceTypeConfigs != nil {
+				result = flattenInstanceTypeConfigs(scaler.Compute.InstanceGroups.TaskGroup.InstanceTypeConfigs)
+			}
+			if err := resourceData.Set(string(TaskInstanceTypeConfig), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskInstanceTypeConfig), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if v, ok := resourceData.GetOk(string(TaskInstanceTypeConfig)); ok {
+				if scaler.Compute.InstanceGroups.TaskGroup == nil {
+					scaler.Compute.InstanceGroups.SetTaskGroup(&mrscaler.InstanceGroup{})
+				}
+
+				if configs, types, err := expandInstanceTypeConfigs(v); err != nil {
+					return err
+				} else {
+					scaler.Compute.InstanceGroups.TaskGroup.SetInstanceTypeConfigs(configs)
+					scaler.Compute.InstanceGroups.TaskGroup.SetInstanceTypes(types)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(TaskInstanceTypeConfig))
+			return err
+		},
+		nil,
+	)
 }
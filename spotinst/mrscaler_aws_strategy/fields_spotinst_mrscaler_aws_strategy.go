@@ -27,6 +27,24 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			var value string
+			if scaler.Strategy != nil {
+				switch {
+				case scaler.Strategy.Wrapping != nil:
+					value = Wrap
+				case scaler.Strategy.Cloning != nil:
+					value = Clone
+				case scaler.Strategy.CreateNew != nil:
+					value = New
+				}
+			}
+			if value != "" {
+				if err := resourceData.Set(string(Strategy), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Strategy), err)
+				}
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -48,6 +66,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			scaler := mrsWrapper.GetMRScalerAWS()
+			if scaler.Strategy != nil && scaler.Strategy.CreateNew != nil &&
+				scaler.Strategy.CreateNew.ReleaseLabel != nil {
+				if err := resourceData.Set(string(ReleaseLabel), scaler.Strategy.CreateNew.ReleaseLabel); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ReleaseLabel), err)
+				}
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,83 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMRScalerAWSDeleteSafeguards(t *testing.T) {
+	const (
+		stepsPath  = "/aws/emr/mrScaler/simrs-12345678/cluster/steps"
+		scalerPath = "/aws/emr/mrScaler/simrs-12345678"
+	)
+	running := []string{
+		`{"id": "s-1", "name": "etl", "state": "RUNNING"}`,
+		`{"id": "s-2", "name": "report", "state": "COMPLETED"}`,
+	}
+
+	cases := map[string]struct {
+		config  map[string]interface{}
+		steps   []string
+		err     string
+		deleted bool
+	}{
+		"active steps": {
+			config: map[string]interface{}{"strategy": "clone", "cluster_id": "j-1"},
+			steps:  running,
+			err:    "has active steps: etl",
+		},
+		"active steps not drained": {
+			config: map[string]interface{}{"strategy": "clone", "cluster_id": "j-1", "drain_steps_timeout": 1},
+			steps:  running,
+			err:    "has active steps: etl",
+		},
+		"completed steps": {
+			config:  map[string]interface{}{"strategy": "new"},
+			steps:   []string{`{"id": "s-2", "name": "report", "state": "COMPLETED"}`},
+			deleted: true,
+		},
+		"termination protected": {
+			config: map[string]interface{}{"strategy": "new", "termination_protected": true},
+			err:    "has termination_protected set",
+		},
+		"force destroy": {
+			config:  map[string]interface{}{"strategy": "new", "termination_protected": true, "force_destroy": true},
+			steps:   running,
+			deleted: true,
+		},
+		"wrapped cluster": {
+			config:  map[string]interface{}{"strategy": "wrap", "cluster_id": "j-1"},
+			steps:   running,
+			deleted: true,
+		},
+	}
+
+	for name, c := range cases {
+		api := newTestOfflineAPI(t)
+		api.Handle("GET", stepsPath, c.steps...)
+		api.Handle("DELETE", scalerPath)
+
+		c.config["name"] = "spark"
+		c.config["region"] = "us-west-2"
+		res := resourceSpotinstMRScalerAWS()
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, c.config)
+		resourceData.SetId("simrs-12345678")
+
+		err := res.Delete(resourceData, api.Client(t))
+		requests := strings.Join(api.Requests(), ",")
+		api.Close()
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("%s: expected error containing %q, got %v", name, c.err, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if deleted := strings.Contains(requests, "DELETE "+scalerPath); deleted != c.deleted {
+			t.Fatalf("%s: expected deleted to be %v, requests were %s", name, c.deleted, requests)
+		}
+	}
+}
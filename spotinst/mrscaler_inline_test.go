@@ -0,0 +1,97 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMRScalerAWSInlineCompute(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("POST", "/aws/emr/mrScaler", testMRScalerAWS)
+	api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678", testMRScalerAWS)
+
+	res := resourceSpotinstMRScalerAWS()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":          "spark",
+		"region":        "us-west-2",
+		"strategy":      "new",
+		"release_label": "emr-5.17.0",
+		"configurations": []interface{}{
+			map[string]interface{}{
+				"classification": "spark-defaults",
+				"properties":     map[string]interface{}{"spark.executor.memory": "4g"},
+			},
+		},
+		"bootstrap_actions": []interface{}{
+			map[string]interface{}{
+				"name": "install",
+				"path": "s3://bucket/install.sh",
+				"args": []interface{}{"--quiet"},
+			},
+		},
+		"steps": []interface{}{
+			map[string]interface{}{
+				"name":       "etl",
+				"jar":        "command-runner.jar",
+				"main_class": "com.example.Main",
+				"args":       []interface{}{"spark-submit", "s3://bucket/etl.py"},
+			},
+		},
+	})
+
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("POST", "/aws/emr/mrScaler")
+	for _, expected := range []string{
+		`"configurations":{"inline":[{"classification":"spark-defaults","properties":{"spark.executor.memory":"4g"}}]}`,
+		`"bootstrapActions":{"inline":[{"name":"install","path":"s3://bucket/install.sh","args":["--quiet"]}]}`,
+		`"steps":{"inline":[{"name":"etl","actionOnFailure":"CONTINUE","jar":"command-runner.jar","mainClass":"com.example.Main","args":["spark-submit","s3://bucket/etl.py"]}]}`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected request body to contain %s, got %s", expected, body)
+		}
+	}
+}
+
+func TestMRScalerAWSInlineComputeConflictsWithFile(t *testing.T) {
+	file := []interface{}{map[string]interface{}{"bucket": "bucket", "key": "key"}}
+	cases := map[string]map[string]interface{}{
+		"configurations": {
+			"configurations":      []interface{}{map[string]interface{}{"classification": "spark"}},
+			"configurations_file": file,
+		},
+		"bootstrap_actions": {
+			"bootstrap_actions":      []interface{}{map[string]interface{}{"name": "install", "path": "s3://bucket/install.sh"}},
+			"bootstrap_actions_file": file,
+		},
+		"steps": {
+			"steps":      []interface{}{map[string]interface{}{"name": "etl", "jar": "command-runner.jar"}},
+			"steps_file": file,
+		},
+	}
+
+	for name, raw := range cases {
+		raw["name"] = "spark"
+		raw["region"] = "us-west-2"
+		raw["strategy"] = "new"
+
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_, errs := resourceSpotinstMRScalerAWS().Validate(terraform.NewResourceConfig(rawConfig))
+		if len(errs) == 0 {
+			t.Fatalf("%s: expected a conflict error", name)
+		}
+		if !strings.Contains(errs[0].Error(), "conflicts with") {
+			t.Fatalf("%s: expected a conflict error, got %v", name, errs)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMRScalerAWSInstanceTypeConfig(t *testing.T) {
+	scaler := strings.Replace(testMRScalerAWS,
+		`"taskGroup": {"instanceTypes": ["m3.xlarge"], `,
+		`"taskGroup": {"instanceTypes": ["m5.2xlarge", "r5.4xlarge"], "instanceTypeConfigs": [`+
+			`{"instanceType": "m5.2xlarge", "weightedCapacity": 8},`+
+			`{"instanceType": "r5.4xlarge", "weightedCapacity": 16, "ebsConfiguration": {"ebsBlockDeviceConfigs": [{"volumesPerInstance": 2, "volumeSpecification": {"volumeType": "gp2", "sizeInGB": 100}}]}}], `, 1)
+
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("POST", "/aws/emr/mrScaler", scaler)
+	api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678", scaler)
+
+	res := resourceSpotinstMRScalerAWS()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":          "spark",
+		"region":        "us-west-2",
+		"strategy":      "new",
+		"release_label": "emr-5.17.0",
+		"task_instance_type_config": []interface{}{
+			map[string]interface{}{"instance_type": "m5.2xlarge", "weighted_capacity": 8},
+			map[string]interface{}{
+				"instance_type":     "r5.4xlarge",
+				"weighted_capacity": 16,
+				"ebs_block_device": []interface{}{
+					map[string]interface{}{"volumes_per_instance": 2, "volume_type": "gp2", "size_in_gb": 100},
+				},
+			},
+		},
+	})
+
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("POST", "/aws/emr/mrScaler")
+	for _, expected := range []string{
+		`"instanceTypes":["m5.2xlarge","r5.4xlarge"]`,
+		`{"instanceType":"m5.2xlarge","weightedCapacity":8}`,
+		`{"instanceType":"r5.4xlarge","weightedCapacity":16,"ebsConfiguration":{"ebsBlockDeviceConfigs":[{"volumesPerInstance":2,"volumeSpecification":{"volumeType":"gp2","sizeInGB":100}}]}}`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected request body to contain %s, got %s", expected, body)
+		}
+	}
+
+	expected := map[string]string{
+		"task_instance_type_config.#":                    "2",
+		"task_instance_type_config.0.instance_type":      "m5.2xlarge",
+		"task_instance_type_config.0.weighted_capacity":  "8",
+		"task_instance_type_config.1.weighted_capacity":  "16",
+		"task_instance_type_config.1.ebs_block_device.#": "1",
+		"core_instance_type_config.#":                    "0",
+	}
+	state := resourceData.State().Attributes
+	for key, value := range expected {
+		if state[key] != value {
+			t.Fatalf("expected %s to be %q, got %q", key, value, state[key])
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testMRScalerAWSWrapped = `{
+  "id": "simrs-12345678",
+  "name": "spark",
+  "region": "us-west-2",
+  "strategy": {"wrapping": {"sourceClusterId": "j-1"}},
+  "compute": {
+    "instanceGroups": {
+      "taskGroup": {"instanceTypes": ["m5.2xlarge"], "lifeCycle": "SPOT", "capacity": {"target": 3, "minimum": 1, "maximum": 6}}
+    }
+  }
+}`
+
+func TestMRScalerAWSStrategyValidation(t *testing.T) {
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"wrap forbids compute": {
+			config: map[string]interface{}{
+				"strategy":              "wrap",
+				"cluster_id":            "j-1",
+				"core_desired_capacity": 2,
+				"log_uri":               "s3://logs",
+				"task_desired_capacity": 2,
+			},
+			err: "wrap strategy does not support: core_desired_capacity, log_uri",
+		},
+		"clone forbids cluster configuration": {
+			config: map[string]interface{}{
+				"strategy":     "clone",
+				"cluster_id":   "j-1",
+				"ec2_key_name": "key",
+			},
+			err: "clone strategy does not support: ec2_key_name",
+		},
+		"clone requires cluster_id": {
+			config: map[string]interface{}{"strategy": "clone"},
+			err:    "clone strategy requires cluster_id",
+		},
+		"new forbids cluster_id": {
+			config: map[string]interface{}{"strategy": "new", "cluster_id": "j-1"},
+			err:    "new strategy does not support cluster_id",
+		},
+	}
+
+	res := resourceSpotinstMRScalerAWS()
+	for name, c := range cases {
+		c.config["name"] = "spark"
+		c.config["region"] = "us-west-2"
+		rawConfig, err := config.NewRawConfig(c.config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		_, err = res.Diff(nil, terraform.NewResourceConfig(rawConfig), nil)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("%s: expected the plan to fail with %q, got %v", name, c.err, err)
+		}
+	}
+
+	// A wrapped cluster only configures its task group.
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":                  "spark",
+		"region":                "us-west-2",
+		"strategy":              "wrap",
+		"cluster_id":            "j-1",
+		"task_desired_capacity": 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := res.Diff(nil, terraform.NewResourceConfig(rawConfig), nil); err != nil {
+		t.Fatalf("expected a valid wrap configuration to plan, got %v", err)
+	}
+}
+
+func TestMRScalerAWSImportWrapped(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678", testMRScalerAWSWrapped)
+	api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678/cluster", `{"id": "j-1", "state": "WAITING"}`)
+
+	res := resourceSpotinstMRScalerAWS()
+	resourceData := res.Data(nil)
+	resourceData.SetId("simrs-12345678")
+
+	results, err := res.Importer.State(resourceData, api.Client(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected a single imported resource, got %d", len(results))
+	}
+
+	expected := map[string]string{
+		"name":                  "spark",
+		"strategy":              "wrap",
+		"cluster_id":            "j-1",
+		"output_cluster_id":     "j-1",
+		"task_instance_types.#": "1",
+		"task_lifecycle":        "SPOT",
+		"task_desired_capacity": "3",
+		"task_min_size":         "1",
+		"task_max_size":         "6",
+	}
+	state := results[0].State().Attributes
+	for key, value := range expected {
+		if state[key] != value {
+			t.Fatalf("expected %s to be %q, got %q", key, value, state[key])
+		}
+	}
+}
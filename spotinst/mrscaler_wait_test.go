@@ -0,0 +1,77 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const testMRScalerAWS = `{
+  "id": "simrs-12345678",
+  "name": "spark",
+  "region": "us-west-2",
+  "strategy": {"new": {"releaseLabel": "emr-5.17.0"}},
+  "compute": {
+    "instanceGroups": {
+      "masterGroup": {"instanceTypes": ["m3.xlarge"], "target": 1},
+      "coreGroup": {"instanceTypes": ["m3.xlarge"], "target": 1, "capacity": {"target": 1, "minimum": 1, "maximum": 1}},
+      "taskGroup": {"instanceTypes": ["m3.xlarge"], "capacity": {"target": 0, "minimum": 0, "maximum": 0}}
+    }
+  },
+  "cluster": {},
+  "scaling": {},
+  "coreScaling": {},
+  "scheduling": {}
+}`
+
+func TestMRScalerAWSWaitForCluster(t *testing.T) {
+	cases := []struct {
+		targetState string
+		cluster     string
+		err         string
+	}{
+		{targetState: "RUNNING", cluster: `{"id": "j-1", "state": "WAITING"}`},
+		{targetState: "WAITING", cluster: `{"id": "j-1", "state": "WAITING"}`},
+		{
+			targetState: "RUNNING",
+			cluster:     `{"id": "j-1", "state": "TERMINATED_WITH_ERRORS", "stateChangeReason": {"code": "VALIDATION_ERROR", "message": "The subnet does not exist"}}`,
+			err:         "VALIDATION_ERROR: The subnet does not exist",
+		},
+		{targetState: "WAITING", cluster: `{"id": "j-1", "state": "RUNNING"}`, err: "did not reach state WAITING"},
+	}
+
+	for _, c := range cases {
+		api := newTestOfflineAPI(t)
+		api.Handle("POST", "/aws/emr/mrScaler", testMRScalerAWS)
+		api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678", testMRScalerAWS)
+		api.Handle("GET", "/aws/emr/mrScaler/simrs-12345678/cluster", c.cluster)
+
+		res := resourceSpotinstMRScalerAWS()
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":              "spark",
+			"region":            "us-west-2",
+			"strategy":          "new",
+			"release_label":     "emr-5.17.0",
+			"expose_cluster_id": true,
+			"wait_for_cluster": []interface{}{
+				map[string]interface{}{"target_state": c.targetState, "timeout": 1},
+			},
+		})
+
+		err := res.Create(resourceData, api.Client(t))
+		api.Close()
+		if c.err == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", c.targetState, err)
+			}
+			if got := resourceData.Get("output_cluster_id").(string); got != "j-1" {
+				t.Fatalf("%s: expected output_cluster_id j-1, got %q", c.targetState, got)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("%s: expected error containing %q, got %v", c.targetState, c.err, err)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+func testTargetSetAttachmentApply(t *testing.T, client *Client) func(*terraform.InstanceState, map[string]interface{}) *terraform.InstanceState {
+	res := resourceSpotinstMultaiTargetSetAttachment()
+	return func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+		raw["balancer_id"] = "lb-1"
+		raw["target_set_id"] = "ts-1"
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		diff, err := res.Diff(state, terraform.NewResourceConfig(rawConfig), client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff == nil {
+			return state
+		}
+		state, err = res.Apply(state, diff, client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return state
+	}
+}
+
+// testTargetRequests returns the requests as "METHOD id body" strings.
+func testTargetRequests(requests []testCollectionRequest) string {
+	got := make([]string, 0, len(requests))
+	for _, request := range requests {
+		got = append(got, request.String())
+	}
+	return strings.Join(got, ", ")
+}
+
+func TestMultaiTargetSetAttachmentTargets(t *testing.T) {
+	api := newTestCollectionAPI("/loadBalancer/target", "target", "t-")
+	defer api.Close()
+	client := api.Client(t)
+	apply := testTargetSetAttachmentApply(t, client)
+
+	// Record what was sent by the time the drain timeout is waited for.
+	var drained []testCollectionRequest
+	var slept []time.Duration
+	defaultSleep := drainSleep
+	drainSleep = func(d time.Duration) {
+		drained = api.RequestLog()
+		slept = append(slept, d)
+	}
+	defer func() { drainSleep = defaultSleep }()
+
+	expectRequests := func(step string, expected ...string) {
+		if got, want := testTargetRequests(api.RequestLog()), strings.Join(expected, ", "); got != want {
+			t.Fatalf("%s: expected requests %s, got %s", step, want, got)
+		}
+	}
+
+	// A target registered by hand, which the attachment adopts when asked to.
+	manual := &multai.Target{}
+	manual.SetBalancerId(spotinst.String("lb-1"))
+	manual.SetTargetSetId(spotinst.String("ts-1"))
+	manual.SetHost(spotinst.String("10.0.0.3"))
+	manual.SetPort(spotinst.Int(80))
+	manual.SetWeight(spotinst.Int(1))
+	if _, err := createTarget(manual, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	api.RequestLog()
+
+	state := apply(nil, map[string]interface{}{
+		"targets": []interface{}{"10.0.0.1:80", "10.0.0.2:80"},
+		"weight":  2,
+	})
+	expectRequests("register",
+		`POST {"balancerId":"lb-1","host":"10.0.0.1","name":"10.0.0.1:80","port":80,"targetSetId":"ts-1","weight":2}`,
+		`POST {"balancerId":"lb-1","host":"10.0.0.2","name":"10.0.0.2:80","port":80,"targetSetId":"ts-1","weight":2}`)
+	first := state.Attributes["target_ids.10.0.0.1:80"]
+
+	state = apply(state, map[string]interface{}{
+		"targets":        []interface{}{"10.0.0.1:80", "10.0.0.3:80"},
+		"weight":         2,
+		"drain_timeout":  1,
+		"adopt_existing": true,
+	})
+	// 10.0.0.2 is drained, removed once the timeout passed, and 10.0.0.3
+	// is adopted and reweighted.
+	if got := testTargetRequests(drained); got != `PUT t-00000003 {"weight":0}` {
+		t.Fatalf("expected only 10.0.0.2 to be drained before the timeout, got %s", got)
+	}
+	if len(slept) != 1 || slept[0] != time.Second {
+		t.Fatalf("expected a single 1s drain timeout, got %v", slept)
+	}
+	expectRequests("swap a target", `DELETE t-00000003`, `PUT t-00000001 {"weight":2}`)
+	if state.Attributes["target_ids.10.0.0.1:80"] != first {
+		t.Fatalf("expected 10.0.0.1:80 to keep target %s, got %v", first, state.Attributes)
+	}
+	if got := state.Attributes["target_ids.10.0.0.3:80"]; got != "t-00000001" {
+		t.Fatalf("expected 10.0.0.3:80 to adopt target t-00000001, got %q", got)
+	}
+
+	state = apply(state, map[string]interface{}{
+		"targets": []interface{}{"10.0.0.1:80", "10.0.0.3:80"},
+		"weight":  5,
+	})
+	expectRequests("change the weight", `PUT t-00000002 {"weight":5}`, `PUT t-00000001 {"weight":5}`)
+	if got := api.Object(first)["weight"]; got != float64(5) {
+		t.Fatalf("expected the weight to be updated in place, got %v", got)
+	}
+
+	// A target deregistered outside Terraform is planned again.
+	api.Remove(first)
+	res := resourceSpotinstMultaiTargetSetAttachment()
+	state, err := res.Refresh(state, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state = apply(state, map[string]interface{}{
+		"targets": []interface{}{"10.0.0.1:80", "10.0.0.3:80"},
+		"weight":  5,
+	})
+	expectRequests("register a deregistered target",
+		`POST {"balancerId":"lb-1","host":"10.0.0.1","name":"10.0.0.1:80","port":80,"targetSetId":"ts-1","weight":5}`)
+	if state.Attributes["targets.#"] != "2" || state.Attributes["target_ids.%"] != "2" {
+		t.Fatalf("expected 2 targets, got %v", state.Attributes)
+	}
+
+	// Targets the attachment doesn't track are left alone on destroy.
+	other := &multai.Target{}
+	other.SetBalancerId(spotinst.String("lb-1"))
+	other.SetTargetSetId(spotinst.String("ts-1"))
+	other.SetHost(spotinst.String("10.0.0.9"))
+	other.SetPort(spotinst.Int(80))
+	otherId, err := createTarget(other, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	api.RequestLog()
+
+	if err := res.Delete(res.Data(state), client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectRequests("destroy", `DELETE t-00000004`, `DELETE t-00000001`)
+	if objects := api.Objects(); len(objects) != 1 || objects[0] != spotinst.StringValue(otherId) {
+		t.Fatalf("expected only the untracked target to remain, got %v", objects)
+	}
+}
+
+func TestMultaiTargetSetAttachmentElastigroup(t *testing.T) {
+	api := newTestCollectionAPI("/loadBalancer/target", "target", "t-")
+	defer api.Close()
+	client := api.Client(t)
+	apply := testTargetSetAttachmentApply(t, client)
+
+	status := "/aws/ec2/group/sig-1/status"
+	api.Handle("GET", status,
+		`{"instanceId": "i-1", "privateIp": "10.0.0.1"}`,
+		`{"instanceId": "i-2", "privateIp": "10.0.0.2"}`,
+		`{"spotInstanceRequestId": "sir-3"}`)
+	raw := map[string]interface{}{"elastigroup_id": "sig-1", "port": 8080}
+
+	state := apply(nil, raw)
+	if got := testTargetRequests(api.RequestLog()); strings.Count(got, "POST") != 2 {
+		t.Fatalf("expected a target per instance, got %s", got)
+	}
+	if _, ok := state.Attributes["target_ids.10.0.0.2:8080"]; !ok {
+		t.Fatalf("expected 10.0.0.2:8080 to be registered, got %v", state.Attributes)
+	}
+
+	// An instance is replaced: the next apply follows the group.
+	api.Handle("GET", status,
+		`{"instanceId": "i-1", "privateIp": "10.0.0.1"}`,
+		`{"instanceId": "i-4", "privateIp": "10.0.0.4"}`)
+	res := resourceSpotinstMultaiTargetSetAttachment()
+	state, err := res.Refresh(state, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state = apply(state, raw)
+	if got := testTargetRequests(api.RequestLog()); !strings.HasPrefix(got, "DELETE t-00000002, POST ") {
+		t.Fatalf("expected the replaced instance to be swapped, got %s", got)
+	}
+	if _, ok := state.Attributes["target_ids.10.0.0.4:8080"]; !ok || state.Attributes["elastigroup_id"] != "sig-1" {
+		t.Fatalf("expected 10.0.0.4:8080 to be registered, got %v", state.Attributes)
+	}
+
+	// In sync, nothing changes.
+	if state, err = res.Refresh(state, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	apply(state, raw)
+	if got := api.RequestLog(); len(got) != 0 {
+		t.Fatalf("expected no changes, got %v", got)
+	}
+}
+
+func TestMultaiTargetSetAttachmentAdoptExisting(t *testing.T) {
+	api := newTestCollectionAPI("/loadBalancer/target", "target", "t-")
+	defer api.Close()
+	client := api.Client(t)
+
+	manual := &multai.Target{}
+	manual.SetBalancerId(spotinst.String("lb-1"))
+	manual.SetTargetSetId(spotinst.String("ts-1"))
+	manual.SetHost(spotinst.String("10.0.0.1"))
+	manual.SetPort(spotinst.Int(80))
+	if _, err := createTarget(manual, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	api.RequestLog()
+
+	res := resourceSpotinstMultaiTargetSetAttachment()
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"balancer_id":   "lb-1",
+		"target_set_id": "ts-1",
+		"targets":       []interface{}{"10.0.0.1:80", "10.0.0.2:80"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	diff, err := res.Diff(nil, terraform.NewResourceConfig(rawConfig), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = res.Apply(nil, diff, client)
+	if err == nil || !strings.Contains(err.Error(), "set adopt_existing to manage it") {
+		t.Fatalf("expected the registered target to be refused, got %v", err)
+	}
+	if got := api.RequestLog(); len(got) != 0 {
+		t.Fatalf("expected no changes, got %v", got)
+	}
+}
+
+func TestMultaiTargetSetAttachmentValidation(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"missing port": {"targets": []interface{}{"10.0.0.1"}},
+		"invalid port": {"targets": []interface{}{"10.0.0.1:99999"}},
+		"both sources": {"targets": []interface{}{"10.0.0.1:80"}, "elastigroup_id": "sig-1"},
+		"zero weight":  {"targets": []interface{}{"10.0.0.1:80"}, "weight": 0},
+	}
+
+	for name, raw := range cases {
+		raw["balancer_id"] = "lb-1"
+		raw["target_set_id"] = "ts-1"
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_, errs := resourceSpotinstMultaiTargetSetAttachment().Validate(terraform.NewResourceConfig(rawConfig))
+		if len(errs) == 0 {
+			t.Fatalf("%s: expected a validation error", name)
+		}
+	}
+}
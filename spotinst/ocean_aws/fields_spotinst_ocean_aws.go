@@ -131,6 +131,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MaxSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value *int = nil
@@ -171,6 +174,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, MinSize, commons.GetCapacityManagementMode(resourceData).ManagesCapacityLimits()) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value *int = nil
@@ -210,6 +216,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Computed: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if !commons.ShouldReadCapacity(resourceData, DesiredCapacity, commons.GetCapacityManagementMode(resourceData).ManagesDesiredCapacity()) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value *int = nil
@@ -352,6 +361,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[commons.CapacityManagement] = commons.NewCapacityManagementField(commons.OceanAWS)
+
+	fieldsMap[commons.DriftSummary] = commons.NewDriftSummaryField(commons.OceanAWS)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -13,4 +13,30 @@ const (
 	Arn                      commons.FieldName = "arn"
 	Name                     commons.FieldName = "name"
 	Type                     commons.FieldName = "type"
+	RootVolumeSize           commons.FieldName = "root_volume_size"
+	EnableMonitoring         commons.FieldName = "enable_monitoring"
+	EbsOptimized             commons.FieldName = "ebs_optimized"
+)
+
+const (
+	EbsBlockDevice      commons.FieldName = "ebs_block_device"
+	DeviceName          commons.FieldName = "device_name"
+	SnapshotId          commons.FieldName = "snapshot_id"
+	VolumeType          commons.FieldName = "volume_type"
+	VolumeSize          commons.FieldName = "volume_size"
+	Iops                commons.FieldName = "iops"
+	DeleteOnTermination commons.FieldName = "delete_on_termination"
+	Encrypted           commons.FieldName = "encrypted"
+	KmsKeyId            commons.FieldName = "kms_key_id"
+)
+
+const (
+	InstanceMetadataOptions commons.FieldName = "instance_metadata_options"
+	HTTPTokens              commons.FieldName = "http_tokens"
+	HTTPPutResponseHopLimit commons.FieldName = "http_put_response_hop_limit"
+)
+
+const (
+	ResourceTagSpecification commons.FieldName = "resource_tag_specification"
+	ShouldTagVolumes         commons.FieldName = "should_tag_volumes"
 )
@@ -1,9 +1,6 @@
 package ocean_aws_launch_configuration
 
 import (
-	"crypto/sha1"
-	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
@@ -207,34 +204,18 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.OceanAWSLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: HexStateFunc,
-		},
+		commons.NewUserDataSchema(commons.UserDataLimitAWS),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.HasUserDataParts(resourceData) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
-			var value = ""
-			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
-				cluster.Compute.LaunchSpecification.UserData != nil {
-
-				userData := cluster.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-					value = string(decodedUserData)
-				}
+			var value *string = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+				value = cluster.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), HexStateFunc(value)); err != nil {
+			if err := resourceData.Set(string(UserData), commons.UserDataStateFromAPI(value)); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
 			}
 			return nil
@@ -243,17 +224,20 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+				userData := spotinst.String(commons.EncodeUserData(v))
 				cluster.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.HasUserDataParts(resourceData) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var userData *string = nil
 			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData = spotinst.String(base64Encode(v))
+				userData = spotinst.String(commons.EncodeUserData(v))
 			}
 			cluster.Compute.LaunchSpecification.SetUserData(userData)
 			return nil
@@ -261,6 +245,23 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[commons.UserDataPartField] = commons.NewUserDataPartsField(
+		commons.OceanAWSLaunchConfiguration,
+		UserData,
+		commons.UserDataLimitAWS,
+		func(resourceObject interface{}) *string {
+			cluster := resourceObject.(*commons.ClusterWrapper).GetCluster()
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+				return cluster.Compute.LaunchSpecification.UserData
+			}
+			return nil
+		},
+		func(resourceObject interface{}, value *string) {
+			cluster := resourceObject.(*commons.ClusterWrapper).GetCluster()
+			cluster.Compute.LaunchSpecification.SetUserData(value)
+		},
+	)
+
 	fieldsMap[AssociatePublicIpAddress] = commons.NewGenericField(
 		commons.OceanAWSLaunchConfiguration,
 		AssociatePublicIpAddress,
@@ -386,6 +387,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	setupLaunchSpecification(fieldsMap)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -393,33 +396,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
 
-func HexStateFunc(v interface{}) string {
-	switch s := v.(type) {
-	case string:
-		hash := sha1.Sum([]byte(s))
-		return hex.EncodeToString(hash[:])
-	default:
-		return ""
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 func expandLb(lb interface{}) ([]*aws.LoadBalancer, error) {
 	list := lb.([]interface{})
 	lbOutput := make([]*aws.LoadBalancer, 0, len(list))
@@ -0,0 +1,484 @@
+package ocean_aws_launch_configuration
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Setup
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func setupLaunchSpecification(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[RootVolumeSize] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		RootVolumeSize,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *int = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.RootVolumeSize != nil {
+				value = cluster.Compute.LaunchSpecification.RootVolumeSize
+			}
+			if err := resourceData.Set(string(RootVolumeSize), spotinst.IntValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(RootVolumeSize), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.Get(string(RootVolumeSize)).(int); ok && v > 0 {
+				cluster.Compute.LaunchSpecification.SetRootVolumeSize(spotinst.Int(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var size *int = nil
+			if v, ok := resourceData.Get(string(RootVolumeSize)).(int); ok && v > 0 {
+				size = spotinst.Int(v)
+			}
+			cluster.Compute.LaunchSpecification.SetRootVolumeSize(size)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EnableMonitoring] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		EnableMonitoring,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *bool = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.Monitoring != nil {
+				value = cluster.Compute.LaunchSpecification.Monitoring
+			}
+			if err := resourceData.Set(string(EnableMonitoring), spotinst.BoolValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EnableMonitoring), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.Get(string(EnableMonitoring)).(bool); ok {
+				cluster.Compute.LaunchSpecification.SetMonitoring(spotinst.Bool(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.Get(string(EnableMonitoring)).(bool); ok {
+				cluster.Compute.LaunchSpecification.SetMonitoring(spotinst.Bool(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EbsOptimized] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		EbsOptimized,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *bool = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.EBSOptimized != nil {
+				value = cluster.Compute.LaunchSpecification.EBSOptimized
+			}
+			if err := resourceData.Set(string(EbsOptimized), spotinst.BoolValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EbsOptimized), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOkExists(string(EbsOptimized)); ok {
+				cluster.Compute.LaunchSpecification.SetEBSOptimized(spotinst.Bool(v.(bool)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOkExists(string(EbsOptimized)); ok {
+				cluster.Compute.LaunchSpecification.SetEBSOptimized(spotinst.Bool(v.(bool)))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EbsBlockDevice] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		EbsBlockDevice,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(DeleteOnTermination): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					string(DeviceName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(Encrypted): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					string(KmsKeyId): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(Iops): {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+
+					string(SnapshotId): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(VolumeSize): {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+
+					string(VolumeType): {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			Set: hashOceanEBSBlockDevice,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value []interface{} = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.BlockDeviceMappings != nil {
+				value = flattenOceanEBSBlockDevices(cluster.Compute.LaunchSpecification.BlockDeviceMappings)
+			}
+			if value != nil {
+				if err := resourceData.Set(string(EbsBlockDevice), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EbsBlockDevice), err)
+				}
+			} else {
+				if err := resourceData.Set(string(EbsBlockDevice), []*aws.BlockDeviceMapping{}); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EbsBlockDevice), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOk(string(EbsBlockDevice)); ok {
+				if devices, err := expandOceanEBSBlockDevices(v); err != nil {
+					return err
+				} else {
+					cluster.Compute.LaunchSpecification.SetBlockDeviceMappings(devices)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value []*aws.BlockDeviceMapping = nil
+			if v, ok := resourceData.GetOk(string(EbsBlockDevice)); ok {
+				if devices, err := expandOceanEBSBlockDevices(v); err != nil {
+					return err
+				} else {
+					value = devices
+				}
+			}
+			cluster.Compute.LaunchSpecification.SetBlockDeviceMappings(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[InstanceMetadataOptions] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		InstanceMetadataOptions,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(HTTPTokens): {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+							if value := v.(string); value != "required" && value != "optional" {
+								return nil, []error{fmt.Errorf("%q must be %q or %q, got %q", k, "required", "optional", value)}
+							}
+							return nil, nil
+						},
+					},
+
+					string(HTTPPutResponseHopLimit): {
+						Type:     schema.TypeInt,
+						Optional: true,
+						ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+							if value := v.(int); value < 1 || value > 64 {
+								return nil, []error{fmt.Errorf("%q must be between 1 and 64, got %d", k, value)}
+							}
+							return nil, nil
+						},
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value []interface{} = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.InstanceMetadataOptions != nil {
+				value = flattenOceanInstanceMetadataOptions(cluster.Compute.LaunchSpecification.InstanceMetadataOptions)
+			}
+			if err := resourceData.Set(string(InstanceMetadataOptions), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceMetadataOptions), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOk(string(InstanceMetadataOptions)); ok {
+				cluster.Compute.LaunchSpecification.SetInstanceMetadataOptions(expandOceanInstanceMetadataOptions(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *aws.InstanceMetadataOptions = nil
+			if v, ok := resourceData.GetOk(string(InstanceMetadataOptions)); ok {
+				value = expandOceanInstanceMetadataOptions(v)
+			}
+			cluster.Compute.LaunchSpecification.SetInstanceMetadataOptions(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ResourceTagSpecification] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		ResourceTagSpecification,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldTagVolumes): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value []interface{} = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.ResourceTagSpecification != nil {
+				value = flattenOceanResourceTagSpecification(cluster.Compute.LaunchSpecification.ResourceTagSpecification)
+			}
+			if err := resourceData.Set(string(ResourceTagSpecification), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ResourceTagSpecification), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOk(string(ResourceTagSpecification)); ok {
+				cluster.Compute.LaunchSpecification.SetResourceTagSpecification(expandOceanResourceTagSpecification(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.ClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *aws.ResourceTagSpecification = nil
+			if v, ok := resourceData.GetOk(string(ResourceTagSpecification)); ok {
+				value = expandOceanResourceTagSpecification(v)
+			}
+			cluster.Compute.LaunchSpecification.SetResourceTagSpecification(value)
+			return nil
+		},
+		nil,
+	)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func hashOceanEBSBlockDevice(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m[string(DeviceName)].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[string(SnapshotId)].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m[string(VolumeSize)].(int)))
+	buf.WriteString(fmt.Sprintf("%t-", m[string(DeleteOnTermination)].(bool)))
+	buf.WriteString(fmt.Sprintf("%t-", m[string(Encrypted)].(bool)))
+	buf.WriteString(fmt.Sprintf("%d-", m[string(Iops)].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m[string(VolumeType)].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[string(KmsKeyId)].(string)))
+	return hashcode.String(buf.String())
+}
+
+func flattenOceanEBSBlockDevices(devices []*aws.BlockDeviceMapping) []interface{} {
+	result := make([]interface{}, 0, len(devices))
+	for _, dev := range devices {
+		if dev.EBS != nil {
+			m := make(map[string]interface{})
+			m[string(DeviceName)] = spotinst.StringValue(dev.DeviceName)
+			m[string(DeleteOnTermination)] = spotinst.BoolValue(dev.EBS.DeleteOnTermination)
+			m[string(Encrypted)] = spotinst.BoolValue(dev.EBS.Encrypted)
+			m[string(KmsKeyId)] = spotinst.StringValue(dev.EBS.KmsKeyId)
+			m[string(Iops)] = spotinst.IntValue(dev.EBS.IOPS)
+			m[string(SnapshotId)] = spotinst.StringValue(dev.EBS.SnapshotID)
+			m[string(VolumeType)] = spotinst.StringValue(dev.EBS.VolumeType)
+			m[string(VolumeSize)] = spotinst.IntValue(dev.EBS.VolumeSize)
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func expandOceanEBSBlockDevices(data interface{}) ([]*aws.BlockDeviceMapping, error) {
+	list := data.(*schema.Set).List()
+	devices := make([]*aws.BlockDeviceMapping, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		device := &aws.BlockDeviceMapping{EBS: &aws.EBS{}}
+
+		if v, ok := m[string(DeviceName)].(string); ok && v != "" {
+			device.SetDeviceName(spotinst.String(v))
+		}
+
+		if v, ok := m[string(DeleteOnTermination)].(bool); ok {
+			device.EBS.SetDeleteOnTermination(spotinst.Bool(v))
+		}
+
+		if v, ok := m[string(Encrypted)].(bool); ok && v != false {
+			device.EBS.SetEncrypted(spotinst.Bool(v))
+		}
+
+		if v, ok := m[string(KmsKeyId)].(string); ok && v != "" {
+			device.EBS.SetKmsKeyId(spotinst.String(v))
+		}
+
+		if v, ok := m[string(SnapshotId)].(string); ok && v != "" {
+			device.EBS.SetSnapshotId(spotinst.String(v))
+		}
+
+		if v, ok := m[string(VolumeType)].(string); ok && v != "" {
+			device.EBS.SetVolumeType(spotinst.String(v))
+		}
+
+		if v, ok := m[string(VolumeSize)].(int); ok && v > 0 {
+			device.EBS.SetVolumeSize(spotinst.Int(v))
+		}
+
+		if v, ok := m[string(Iops)].(int); ok && v > 0 {
+			device.EBS.SetIOPS(spotinst.Int(v))
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+func flattenOceanInstanceMetadataOptions(options *aws.InstanceMetadataOptions) []interface{} {
+	result := make(map[string]interface{})
+	result[string(HTTPTokens)] = spotinst.StringValue(options.HTTPTokens)
+	result[string(HTTPPutResponseHopLimit)] = spotinst.IntValue(options.HTTPPutResponseHopLimit)
+	return []interface{}{result}
+}
+
+func expandOceanInstanceMetadataOptions(data interface{}) *aws.InstanceMetadataOptions {
+	options := &aws.InstanceMetadataOptions{}
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(HTTPTokens)].(string); ok && v != "" {
+			options.SetHTTPTokens(spotinst.String(v))
+		}
+
+		if v, ok := m[string(HTTPPutResponseHopLimit)].(int); ok && v > 0 {
+			options.SetHTTPPutResponseHopLimit(spotinst.Int(v))
+		}
+	}
+	return options
+}
+
+func flattenOceanResourceTagSpecification(spec *aws.ResourceTagSpecification) []interface{} {
+	result := make(map[string]interface{})
+	if spec.Volumes != nil {
+		result[string(ShouldTagVolumes)] = spotinst.BoolValue(spec.Volumes.ShouldTag)
+	}
+	return []interface{}{result}
+}
+
+func expandOceanResourceTagSpecification(data interface{}) *aws.ResourceTagSpecification {
+	spec := &aws.ResourceTagSpecification{}
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(ShouldTagVolumes)].(bool); ok {
+			spec.SetVolumes(&aws.VolumesTagSpecification{})
+			spec.Volumes.SetShouldTag(spotinst.Bool(v))
+		}
+	}
+	return spec
+}
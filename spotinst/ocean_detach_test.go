@@ -0,0 +1,56 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestOceanAWSDetach(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/ocean/aws/k8s/cluster/o-12345678/instances",
+		`{"instanceId": "i-1", "instanceType": "m5.large"}`,
+		`{"instanceId": "i-2", "instanceType": "m5.large"}`)
+	api.Handle("PUT", "/ocean/aws/k8s/cluster/o-12345678/detachInstances")
+
+	res := resourceSpotinstOceanAWSDetach()
+
+	foreign := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"ocean_id":     "o-12345678",
+		"instance_ids": []interface{}{"i-1", "i-9"},
+	})
+	if err := res.Create(foreign, api.Client(t)); err == nil || !strings.Contains(err.Error(), "i-9 do not belong") {
+		t.Fatalf("expected an error for a foreign instance, got %v", err)
+	}
+	if body := api.RequestBody("PUT", "/ocean/aws/k8s/cluster/o-12345678/detachInstances"); body != "" {
+		t.Fatalf("expected no detach request, got %s", body)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"ocean_id":                         "o-12345678",
+		"instance_ids":                     []interface{}{"i-2", "i-1"},
+		"should_decrement_target_capacity": true,
+	})
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("PUT", "/ocean/aws/k8s/cluster/o-12345678/detachInstances")
+	for _, expected := range []string{
+		`"instancesToDetach":["i-1","i-2"]`,
+		`"shouldDecrementTargetCapacity":true`,
+		`"shouldTerminateInstances":true`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected %s in detach request, got %s", expected, body)
+		}
+	}
+	if !strings.HasPrefix(resourceData.Id(), "o-12345678-") {
+		t.Fatalf("unexpected ID %q", resourceData.Id())
+	}
+	if got := resourceData.Get("detached_instance_ids").([]interface{}); len(got) != 2 {
+		t.Fatalf("expected 2 detached instances, got %v", got)
+	}
+}
@@ -0,0 +1,139 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testOceanLaunchSpecificationCluster = `{
+  "id": "o-12345678",
+  "name": "hardened",
+  "controllerClusterId": "hardened",
+  "region": "us-west-2",
+  "capacity": {"minimum": 0, "maximum": 2, "target": 1},
+  "compute": {
+    "subnetIds": ["subnet-123"],
+    "launchSpecification": {
+      "imageId": "ami-12345678",
+      "securityGroupIds": ["sg-123"],
+      "rootVolumeSize": 50,
+      "monitoring": true,
+      "ebsOptimized": true,
+      "blockDeviceMappings": [{"deviceName": "/dev/xvda", "ebs": {"volumeSize": 50, "volumeType": "gp2", "encrypted": true, "deleteOnTermination": true}}],
+      "instanceMetadataOptions": {"httpTokens": "required", "httpPutResponseHopLimit": 2},
+      "resourceTagSpecification": {"volumes": {"shouldTag": true}}
+    }
+  }
+}`
+
+func TestOceanAWSLaunchSpecification(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("POST", "/ocean/aws/k8s/cluster", testOceanLaunchSpecificationCluster)
+	api.Handle("GET", "/ocean/aws/k8s/cluster/o-12345678", testOceanLaunchSpecificationCluster)
+
+	res := resourceSpotinstOceanAWS()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":              "hardened",
+		"controller_id":     "hardened",
+		"region":            "us-west-2",
+		"subnet_ids":        []interface{}{"subnet-123"},
+		"image_id":          "ami-12345678",
+		"security_groups":   []interface{}{"sg-123"},
+		"root_volume_size":  50,
+		"enable_monitoring": true,
+		"ebs_optimized":     true,
+		"ebs_block_device": []interface{}{
+			map[string]interface{}{
+				"device_name":           "/dev/xvda",
+				"volume_size":           50,
+				"volume_type":           "gp2",
+				"encrypted":             true,
+				"delete_on_termination": true,
+			},
+		},
+		"instance_metadata_options": []interface{}{
+			map[string]interface{}{"http_tokens": "required", "http_put_response_hop_limit": 2},
+		},
+		"resource_tag_specification": []interface{}{
+			map[string]interface{}{"should_tag_volumes": true},
+		},
+	})
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("POST", "/ocean/aws/k8s/cluster")
+	for _, expected := range []string{
+		`"rootVolumeSize":50`,
+		`"monitoring":true`,
+		`"ebsOptimized":true`,
+		`"blockDeviceMappings":[{"deviceName":"/dev/xvda","ebs":{"deleteOnTermination":true,"encrypted":true,"volumeType":"gp2","volumeSize":50}}]`,
+		`"instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":2}`,
+		`"resourceTagSpecification":{"volumes":{"shouldTag":true}}`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected %s in create request, got %s", expected, body)
+		}
+	}
+
+	if got := resourceData.Get("instance_metadata_options.0.http_tokens").(string); got != "required" {
+		t.Fatalf("expected http_tokens required, got %q", got)
+	}
+	if got := resourceData.Get("resource_tag_specification.0.should_tag_volumes").(bool); !got {
+		t.Fatalf("expected should_tag_volumes to be read back")
+	}
+	if got := resourceData.Get("ebs_block_device").(*schema.Set).Len(); got != 1 {
+		t.Fatalf("expected a single ebs_block_device, got %d", got)
+	}
+}
+
+func TestOceanAWSEBSBlockDeviceDiff(t *testing.T) {
+	device := map[string]interface{}{
+		"device_name": "/dev/xvda",
+		"volume_size": 50,
+		"volume_type": "gp2",
+		"encrypted":   true,
+	}
+	res := resourceSpotinstOceanAWS()
+	prior := res.Data(&terraform.InstanceState{ID: "o-12345678"})
+	prior.Set("ebs_block_device", []interface{}{device})
+
+	for field, value := range map[string]interface{}{
+		"volume_type": "gp3",
+		"kms_key_id":  "arn:aws:kms:us-west-2:123456789012:key/abcd",
+	} {
+		changed := make(map[string]interface{})
+		for k, v := range device {
+			changed[k] = v
+		}
+		changed[field] = value
+
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"ebs_block_device": []interface{}{changed},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		diff, err := res.Diff(prior.State(), terraform.NewResourceConfig(rawConfig), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		found := false
+		if diff != nil {
+			for key, attr := range diff.Attributes {
+				if strings.HasPrefix(key, "ebs_block_device.") && strings.HasSuffix(key, "."+field) && attr.New == value {
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("expected changing only %s to show in the plan, got %v", field, diff)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package spotinst
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testOfflineAPI is a local stand-in of the Spotinst API. It serves canned
+// response items keyed by "METHOD path" and records every request it receives.
+type testOfflineAPI struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	responses map[string][]string
+	requests  []string
+	bodies    map[string]string
+}
+
+func newTestOfflineAPI(t *testing.T) *testOfflineAPI {
+	api := &testOfflineAPI{
+		responses: make(map[string][]string),
+		bodies:    make(map[string]string),
+	}
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	return api
+}
+
+// Handle registers the JSON items returned for requests matching method and path.
+func (api *testOfflineAPI) Handle(method, path string, items ...string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.responses[method+" "+path] = items
+}
+
+// Requests returns the "METHOD path" of every request received so far.
+func (api *testOfflineAPI) Requests() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]string(nil), api.requests...)
+}
+
+// RequestBody returns the body of the last request matching method and path.
+func (api *testOfflineAPI) RequestBody(method, path string) string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.bodies[method+" "+path]
+}
+
+// Client returns a provider client configured against the stand-in.
+func (api *testOfflineAPI) Client(t *testing.T) *Client {
+	config := Config{
+		Token:   "fake",
+		Account: "fake",
+		BaseURL: api.server.URL,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to configure client: %v", err)
+	}
+	return client
+}
+
+func (api *testOfflineAPI) Close() {
+	api.server.Close()
+}
+
+func (api *testOfflineAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + r.URL.Path
+	body, _ := ioutil.ReadAll(r.Body)
+
+	api.mu.Lock()
+	api.requests = append(api.requests, key)
+	api.bodies[key] = string(body)
+	items, ok := api.responses[key]
+	api.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"request":{"id":"offline"},"response":{"errors":[{"code":"NOT_FOUND","message":"no stand-in response for %s"}]}}`, key)
+		return
+	}
+	fmt.Fprintf(w, `{"request":{"id":"offline"},"response":{"items":[%s]}}`, strings.Join(items, ","))
+}
+
+// testCollectionAPI is a stateful stand-in of a collection of the Spotinst
+// API: objects posted to path are listed, updated and deleted at path/{id},
+// so resources can be reconciled against what earlier applies created.
+// Listings are filtered by their query parameters. Other requests are served
+// the canned items registered with Handle.
+type testCollectionAPI struct {
+	server *httptest.Server
+	path   string
+	key    string
+	prefix string
+
+	mu        sync.Mutex
+	nextId    int
+	objects   map[string]map[string]interface{}
+	responses map[string][]string
+	requests  []testCollectionRequest
+}
+
+// testCollectionRequest is a request to the collection: the ID of the object
+// it targets, if any, and the fields it sent other than the ID.
+type testCollectionRequest struct {
+	Method string
+	Id     string
+	Body   string
+}
+
+func (r testCollectionRequest) String() string {
+	return strings.Join(strings.Fields(r.Method+" "+r.Id+" "+r.Body), " ")
+}
+
+func newTestCollectionAPI(path, key, prefix string) *testCollectionAPI {
+	api := &testCollectionAPI{
+		path:      path,
+		key:       key,
+		prefix:    prefix,
+		objects:   make(map[string]map[string]interface{}),
+		responses: make(map[string][]string),
+	}
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	return api
+}
+
+// Handle registers the JSON items returned for requests matching method and path.
+func (api *testCollectionAPI) Handle(method, path string, items ...string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.responses[method+" "+path] = items
+}
+
+// Requests returns the methods of the requests to the collection received
+// since the last call, sorted and without the listings.
+func (api *testCollectionAPI) Requests() []string {
+	var requests []string
+	for _, request := range api.RequestLog() {
+		requests = append(requests, request.Method)
+	}
+	sort.Strings(requests)
+	return requests
+}
+
+// RequestLog returns the requests to the collection received since the last
+// call, in order and without the listings.
+func (api *testCollectionAPI) RequestLog() []testCollectionRequest {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	requests := api.requests
+	api.requests = nil
+	return requests
+}
+
+// Add stores an object under its own ID, as if posted by an earlier apply.
+func (api *testCollectionAPI) Add(object map[string]interface{}) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.objects[object["id"].(string)] = object
+}
+
+// Object returns the stored object with the given ID.
+func (api *testCollectionAPI) Object(id string) map[string]interface{} {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.objects[id]
+}
+
+// Objects returns the IDs of the stored objects.
+func (api *testCollectionAPI) Objects() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.sortedIds()
+}
+
+// Remove deletes an object, as if it was deleted outside Terraform.
+func (api *testCollectionAPI) Remove(id string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	delete(api.objects, id)
+}
+
+// Client returns a provider client configured against the stand-in.
+func (api *testCollectionAPI) Client(t *testing.T) *Client {
+	config := Config{
+		Token:   "fake",
+		Account: "fake",
+		BaseURL: api.server.URL,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to configure client: %v", err)
+	}
+	return client
+}
+
+func (api *testCollectionAPI) Close() {
+	api.server.Close()
+}
+
+func (api *testCollectionAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	key := r.Method + " " + r.URL.Path
+
+	var input map[string]map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&input)
+
+	var items []string
+	id := strings.TrimPrefix(r.URL.Path, api.path+"/")
+	object := api.objects[id]
+	if strings.HasPrefix(r.URL.Path, api.path) && key != "GET "+api.path {
+		request := testCollectionRequest{Method: r.Method}
+		if r.URL.Path != api.path {
+			request.Id = id
+		}
+		if fields := input[api.key]; len(fields) > 0 {
+			body := make(map[string]interface{}, len(fields))
+			for k, v := range fields {
+				if k != "id" {
+					body[k] = v
+				}
+			}
+			request.Body = api.encode(body)
+		}
+		api.requests = append(api.requests, request)
+	}
+	switch {
+	case r.Method == "GET" && r.URL.Path == api.path:
+		for _, id := range api.sortedIds() {
+			if api.matches(api.objects[id], r.URL.Query()) {
+				items = append(items, api.encode(api.objects[id]))
+			}
+		}
+	case r.Method == "POST" && r.URL.Path == api.path:
+		api.nextId++
+		object = input[api.key]
+		object["id"] = fmt.Sprintf("%s%08d", api.prefix, api.nextId)
+		api.objects[object["id"].(string)] = object
+		items = append(items, api.encode(object))
+	case r.Method == "PUT" && object != nil:
+		for k, v := range input[api.key] {
+			object[k] = v
+		}
+		items = append(items, api.encode(object))
+	case r.Method == "DELETE" && object != nil:
+		delete(api.objects, id)
+	default:
+		canned, ok := api.responses[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"request":{"id":"offline"},"response":{"errors":[{"code":"NOT_FOUND","message":"no stand-in response for %s"}]}}`, key)
+			return
+		}
+		items = canned
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"request":{"id":"offline"},"response":{"items":[%s]}}`, strings.Join(items, ","))
+}
+
+func (api *testCollectionAPI) sortedIds() []string {
+	ids := make([]string, 0, len(api.objects))
+	for id := range api.objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (api *testCollectionAPI) matches(object map[string]interface{}, query url.Values) bool {
+	for k := range query {
+		// Every request carries the account, which objects don't.
+		if k == "accountId" {
+			continue
+		}
+		if fmt.Sprint(object[k]) != query.Get(k) {
+			return false
+		}
+	}
+	return true
+}
+
+func (api *testCollectionAPI) encode(object map[string]interface{}) string {
+	b, _ := json.Marshal(object)
+	return string(b)
+}
@@ -1,6 +1,8 @@
 package spotinst
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
@@ -24,23 +26,47 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarAccount, ""),
 				Description: "Spotinst Account ID",
 			},
+
+			string(commons.ProviderDriftReporting): {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(commons.DriftReportingDisabled),
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					switch commons.DriftReportingMode(v.(string)) {
+					case commons.DriftReportingDisabled, commons.DriftReportingLog, commons.DriftReportingSummary:
+						return nil, nil
+					}
+					return nil, []error{fmt.Errorf("%q must be one of %q, %q or %q, got %q", k,
+						commons.DriftReportingDisabled, commons.DriftReportingLog, commons.DriftReportingSummary, v)}
+				},
+				Description: "Report fields changed outside Terraform on refresh: disabled, log or summary",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			string(commons.ElastigroupAwsResourceName):          resourceSpotinstElastigroupAws(),
-			string(commons.ElastigroupGCPResourceName):          resourceSpotinstElastigroupGCP(),
-			string(commons.ElastigroupGKEResourceName):          resourceSpotinstElastigroupGKE(),
-			string(commons.SubscriptionResourceName):            resourceSpotinstSubscription(),
-			string(commons.ElastigroupAWSBeanstalkResourceName): resourceSpotinstElastigroupAWSBeanstalk(),
-			string(commons.OceanAWSResourceName):                resourceSpotinstOceanAWS(),
-			string(commons.ElastigroupAzureResourceName):        resourceSpotinstElastigroupAzure(),
-			string(commons.MRScalerAWSResourceName):             resourceSpotinstMRScalerAWS(),
-			string(commons.MultaiBalancerResourceName):          resourceSpotinstMultaiBalancer(),
-			string(commons.MultaiDeploymentResourceName):        resourceSpotinstMultaiDeployment(),
-			string(commons.MultaiListenerResourceName):          resourceSpotinstMultaiListener(),
-			string(commons.MultaiRoutingRuleResourceName):       resourceSpotinstMultaiRoutingRule(),
-			string(commons.MultaiTargetResourceName):            resourceSpotinstMultaiTarget(),
-			string(commons.MultaiTargetSetResourceName):         resourceSpotinstMultaiTargetSet(),
+			string(commons.ElastigroupAwsResourceName):            resourceSpotinstElastigroupAws(),
+			string(commons.ElastigroupGCPResourceName):            resourceSpotinstElastigroupGCP(),
+			string(commons.ElastigroupGKEResourceName):            resourceSpotinstElastigroupGKE(),
+			string(commons.SubscriptionResourceName):              resourceSpotinstSubscription(),
+			string(commons.SubscriptionSetResourceName):           resourceSpotinstSubscriptionSet(),
+			string(commons.ElastigroupAWSBeanstalkResourceName):   resourceSpotinstElastigroupAWSBeanstalk(),
+			string(commons.OceanAWSResourceName):                  resourceSpotinstOceanAWS(),
+			string(commons.OceanAWSDetachResourceName):            resourceSpotinstOceanAWSDetach(),
+			string(commons.ElastigroupAzureResourceName):          resourceSpotinstElastigroupAzure(),
+			string(commons.MRScalerAWSResourceName):               resourceSpotinstMRScalerAWS(),
+			string(commons.MultaiBalancerResourceName):            resourceSpotinstMultaiBalancer(),
+			string(commons.MultaiDeploymentResourceName):          resourceSpotinstMultaiDeployment(),
+			string(commons.MultaiListenerResourceName):            resourceSpotinstMultaiListener(),
+			string(commons.MultaiRoutingRuleResourceName):         resourceSpotinstMultaiRoutingRule(),
+			string(commons.MultaiTargetResourceName):              resourceSpotinstMultaiTarget(),
+			string(commons.MultaiTargetSetResourceName):           resourceSpotinstMultaiTargetSet(),
+			string(commons.MultaiTargetSetAttachmentResourceName): resourceSpotinstMultaiTargetSetAttachment(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			string(commons.ElastigroupAwsInstancesDataSourceName): dataSourceSpotinstElastigroupAwsInstances(),
+			string(commons.MultaiBalancerDataSourceName):          dataSourceSpotinstMultaiBalancer(),
+			string(commons.MultaiTargetSetHealthDataSourceName):   dataSourceSpotinstMultaiTargetSetHealth(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -49,8 +75,9 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		Token:   d.Get(string(commons.ProviderToken)).(string),
-		Account: d.Get(string(commons.ProviderAccount)).(string),
+		Token:          d.Get(string(commons.ProviderToken)).(string),
+		Account:        d.Get(string(commons.ProviderAccount)).(string),
+		DriftReporting: d.Get(string(commons.ProviderDriftReporting)).(string),
 	}
 	if err := config.Validate(); err != nil {
 		return nil, err
@@ -35,9 +35,11 @@ func resourceSpotinstElastigroupAws() *schema.Resource {
 		Delete: resourceSpotinstElastigroupAwsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.ElastigroupAwsResourceName, ElastigroupIDPrefix, lookupElastigroupAWSByName),
 		},
 
+		CustomizeDiff: elastigroup_aws_block_devices.ValidateEBSVolumePool,
+
 		Schema: commons.ElastigroupResource.GetSchemaMap(),
 	}
 }
@@ -25,7 +25,7 @@ func resourceSpotinstElastigroupAWSBeanstalk() *schema.Resource {
 		Delete: resourceSpotinstAWSBeanstalkGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.ElastigroupAWSBeanstalkResourceName, ElastigroupIDPrefix, lookupElastigroupAWSByName),
 		},
 
 		Schema: commons.ElastigroupAWSBeanstalkResource.GetSchemaMap(),
@@ -131,7 +131,7 @@ func toggleMaintenanceMode(resourceData *schema.ResourceData, meta interface{},
 //            Create
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 func resourceSpotinstAWSBeanstalkGroupCreate(resourceData *schema.ResourceData, meta interface{}) error {
-	log.Print(string(commons.ResourceOnCreate),
+	log.Printf(string(commons.ResourceOnCreate),
 		commons.ElastigroupAWSBeanstalkResource.GetName())
 
 	beanstalkGroup, err := importBeanstalkGroup(resourceData, meta.(*Client))
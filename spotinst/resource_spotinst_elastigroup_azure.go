@@ -12,10 +12,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_health_check"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_image"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_integrations"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_launch_configuration"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_load_balancer"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_login"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_network"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_scaling_policies"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_scheduled_task"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_strategy"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_vm_sizes"
@@ -34,7 +36,7 @@ func resourceSpotinstElastigroupAzure() *schema.Resource {
 		Delete: resourceSpotinstElastigroupAzureDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.ElastigroupAzureResourceName, ElastigroupIDPrefix, lookupElastigroupAzureByName),
 		},
 
 		Schema: commons.ElastigroupAzureResource.GetSchemaMap(),
@@ -47,6 +49,7 @@ func setupElastigroupAzureResource() {
 	elastigroup_azure.Setup(fieldsMap)
 	elastigroup_azure_health_check.Setup(fieldsMap)
 	elastigroup_azure_image.Setup(fieldsMap)
+	elastigroup_azure_integrations.Setup(fieldsMap)
 	elastigroup_azure_launch_configuration.Setup(fieldsMap)
 	elastigroup_azure_load_balancer.Setup(fieldsMap)
 	elastigroup_azure_login.Setup(fieldsMap)
@@ -54,6 +57,7 @@ func setupElastigroupAzureResource() {
 	elastigroup_azure_strategy.Setup(fieldsMap)
 	elastigroup_azure_vm_sizes.Setup(fieldsMap)
 	elastigroup_azure_scheduled_task.Setup(fieldsMap)
+	elastigroup_azure_scaling_policies.Setup(fieldsMap)
 
 	commons.ElastigroupAzureResource = commons.NewElastigroupAzureResource(fieldsMap)
 }
@@ -231,7 +235,7 @@ func rollAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
 						log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupId, json)
 						errResult = resource.Retry(time.Minute*5, func() *resource.RetryError {
 							rollGroupInput.GroupID = spotinst.String(groupId)
-							_, err := meta.(*Client).elastigroup.CloudProviderAzure().Roll(context.Background(), rollGroupInput)
+							rollOut, err := meta.(*Client).elastigroup.CloudProviderAzure().Roll(context.Background(), rollGroupInput)
 							if err != nil {
 								// checks whether to retry role
 								if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -245,6 +249,10 @@ func rollAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
 								// Some other error, report it.
 								return resource.NonRetryableError(err)
 							}
+
+							if err := awaitReadyAzureRoll(groupId, rollConfig, rollOut, meta.(*Client)); err != nil {
+								return resource.NonRetryableError(err)
+							}
 							log.Printf("onRoll() -> Successfully rolled group [%v]", groupId)
 							return nil
 						})
@@ -259,6 +267,69 @@ func rollAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
 	return errResult
 }
 
+// awaitReadyAzureRoll waits until the roll reaches wait_for_roll_percentage. When
+// wait_for_roll_timeout elapses first, the roll is stopped if stop_roll_on_timeout is set.
+func awaitReadyAzureRoll(groupId string, rollConfig interface{}, rollOut *azure.RollGroupOutput, client *Client) error {
+	pctTimeout := spotinst.IntValue(getAzureRollConfigInt(rollConfig, elastigroup_azure.WaitForRollTimeout))
+	pctComplete := spotinst.IntValue(getAzureRollConfigInt(rollConfig, elastigroup_azure.WaitForRollPct))
+	rollId := spotinst.StringValue(getAzureRollStatus(rollOut))
+
+	if pctTimeout <= 0 || pctComplete <= 0 || rollId == "" {
+		return nil
+	}
+
+	rollStatusInput := &azure.RollStatusInput{GroupID: spotinst.String(groupId), RollID: spotinst.String(rollId)}
+	err := resource.Retry(time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
+		rollStatus, err := client.elastigroup.CloudProviderAzure().GetRollStatus(context.Background(), rollStatusInput)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitReadyAzureRoll() -> Roll group status [%v] API call failed, error: %v", groupId, err))
+		}
+
+		progress := 0
+		if rollStatus.RollStatus != nil {
+			status := strings.ToUpper(spotinst.StringValue(rollStatus.RollStatus.Status))
+			if status == "FAILED" || status == "STOPPED" {
+				return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitReadyAzureRoll() -> Roll [%v] of group [%v] is %s", rollId, groupId, status))
+			}
+			if rollStatus.RollStatus.Progress != nil {
+				progress = spotinst.IntValue(rollStatus.RollStatus.Progress.Value)
+			}
+		}
+		if progress < pctComplete {
+			log.Printf("===> waiting for at least %d%% of batches to complete, currently %d%% <===\n", pctComplete, progress)
+			return resource.RetryableError(fmt.Errorf("===> roll at %v%% complete <===", progress))
+		}
+		return nil
+	})
+
+	if err != nil {
+		if getAzureRollConfigBool(rollConfig, elastigroup_azure.StopRollOnTimeout) {
+			if stopErr := stopAzureRoll(groupId, rollId, client); stopErr != nil {
+				return fmt.Errorf("[ERROR] Did not reach target deployment amount and failed to stop the roll. Message: %s, %s", err, stopErr)
+			}
+			return fmt.Errorf("[ERROR] Did not reach target deployment amount, roll [%v] was stopped. Message: %s", rollId, err)
+		}
+		return fmt.Errorf("[ERROR] Did not reach target deployment amount. Message: %s", err)
+	}
+
+	log.Printf("awaitReadyAzureRoll() -> Target deployment percentage reached [%v]", groupId)
+	return nil
+}
+
+func stopAzureRoll(groupId string, rollId string, client *Client) error {
+	input := &azure.StopRollInput{
+		GroupID: spotinst.String(groupId),
+		RollID:  spotinst.String(rollId),
+		Roll:    &azure.Roll{Status: spotinst.String("STOPPED")},
+	}
+
+	log.Printf("onRoll() -> Stopping roll [%v] of group [%v]", rollId, groupId)
+	if _, err := client.elastigroup.CloudProviderAzure().StopRoll(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Failed to stop roll [%v] of group [%v]: %v", rollId, groupId, err)
+	}
+	return nil
+}
+
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //            Delete
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -318,6 +389,31 @@ func expandElastigroupAzureRollConfig(data interface{}, groupID *string) (*azure
 	return i, nil
 }
 
+func getAzureRollConfigInt(data interface{}, fieldName commons.FieldName) *int {
+	var value *int
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(fieldName)].(int); ok {
+			value = spotinst.Int(v)
+		}
+	}
+	return value
+}
+
+func getAzureRollConfigBool(data interface{}, fieldName commons.FieldName) bool {
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(fieldName)].(bool); ok {
+			return v
+		}
+	}
+	return false
+}
+
 func getAzureRollStatus(rollOut *azure.RollGroupOutput) *string {
 	for item := range rollOut.Items {
 		rs := strings.ToUpper(spotinst.StringValue(rollOut.Items[item].Status))
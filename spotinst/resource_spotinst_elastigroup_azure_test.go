@@ -8,7 +8,6 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
-	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_azure_launch_configuration"
 	"log"
 	"testing"
 )
@@ -405,7 +404,7 @@ func TestAccSpotinstElastigroupAzure_LaunchConfiguration(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckElastigroupAzureExists(&group, resourceName),
 					testCheckElastigroupAzureAttributes(&group, groupName),
-					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_azure_launch_configuration.HexStateFunc("hello world"))),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("hello world"))),
 			},
 			{
 				Config: createElastigroupAzureTerraform(&AzureGroupConfigMetadata{
@@ -415,7 +414,7 @@ func TestAccSpotinstElastigroupAzure_LaunchConfiguration(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckElastigroupAzureExists(&group, resourceName),
 					testCheckElastigroupAzureAttributes(&group, groupName),
-					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_azure_launch_configuration.HexStateFunc("hello world"))),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("hello world"))),
 			},
 		},
 	})
@@ -862,6 +861,163 @@ const testAzureScheduledTaskGroupConfig_EmptyFields = `
 
 // endregion
 
+// region Azure Elastigroup: Scaling Policies
+func TestAccSpotinstElastigroupAzure_ScalingPolicies(t *testing.T) {
+	groupName := "eg-azure-scaling-policies"
+	resourceName := createElastigroupAzureResourceName(groupName)
+
+	var group azure.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t, "azure") },
+		Providers:     TestAccProviders,
+		CheckDestroy:  testElastigroupAzureDestroy,
+		IDRefreshName: resourceName,
+
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceName,
+				Config: createElastigroupAzureTerraform(&AzureGroupConfigMetadata{
+					groupName:      groupName,
+					fieldsToAppend: testAzureScalingPoliciesGroupConfig_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAzureExists(&group, resourceName),
+					testCheckElastigroupAzureAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.policy_name", "policy-name"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.namespace", "Microsoft.Compute"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.statistic", "average"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.unit", "percent"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.threshold", "10"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.operator", "gte"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.evaluation_periods", "10"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.cooldown", "60"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.action_type", "adjustment"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.adjustment", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2193048116.dimensions.name-1", "value-1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.2059023644.policy_name", "policy-name-update"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.2059023644.action_type", "updateCapacity"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.2059023644.minimum", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.2059023644.maximum", "10"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.2059023644.target", "5"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createElastigroupAzureTerraform(&AzureGroupConfigMetadata{
+					groupName:      groupName,
+					fieldsToAppend: testAzureScalingPoliciesGroupConfig_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAzureExists(&group, resourceName),
+					testCheckElastigroupAzureAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.policy_name", "policy-name-update"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.statistic", "sum"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.unit", "bytes"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.threshold", "5"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.operator", "lte"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.evaluation_periods", "5"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.period", "120"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.cooldown", "120"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.2059023644.dimensions.name-1-update", "value-1-update"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.#", "0"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createElastigroupAzureTerraform(&AzureGroupConfigMetadata{
+					groupName:      groupName,
+					fieldsToAppend: testAzureScalingPoliciesGroupConfig_EmptyFields,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAzureExists(&group, resourceName),
+					testCheckElastigroupAzureAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAzureScalingPoliciesGroupConfig_Create = `
+ // --- SCALING POLICIES ----------------
+  scaling_up_policy = [{
+    policy_name = "policy-name"
+    metric_name = "CPUUtilization"
+    namespace = "Microsoft.Compute"
+    statistic = "average"
+    unit = "percent"
+    threshold = 10
+    operator = "gte"
+    evaluation_periods = 10
+    period = 60
+    cooldown = 60
+    action_type = "adjustment"
+    adjustment = "1"
+    dimensions = {
+      name-1 = "value-1"
+    }
+  }]
+
+  scaling_down_policy = [{
+    policy_name = "policy-name-update"
+    metric_name = "CPUUtilization"
+    namespace = "Microsoft.Compute"
+    statistic = "sum"
+    unit = "bytes"
+    threshold = 5
+    operator = "lte"
+    evaluation_periods = 5
+    period = 120
+    cooldown = 120
+    action_type = "updateCapacity"
+    minimum = "0"
+    maximum = "10"
+    target = "5"
+    dimensions = {
+      name-1-update = "value-1-update"
+    }
+  }]
+ // -------------------------------------
+`
+
+const testAzureScalingPoliciesGroupConfig_Update = `
+ // --- SCALING POLICIES ----------------
+  scaling_up_policy = [{
+    policy_name = "policy-name-update"
+    metric_name = "CPUUtilization"
+    namespace = "Microsoft.Compute"
+    statistic = "sum"
+    unit = "bytes"
+    threshold = 5
+    operator = "lte"
+    evaluation_periods = 5
+    period = 120
+    cooldown = 120
+    action_type = "updateCapacity"
+    minimum = "0"
+    maximum = "10"
+    target = "5"
+    dimensions = {
+      name-1-update = "value-1-update"
+    }
+  }]
+ // -------------------------------------
+`
+
+const testAzureScalingPoliciesGroupConfig_EmptyFields = `
+ // --- SCALING POLICIES ----------------
+ // -------------------------------------
+`
+
+// endregion
+
 // region Elastigroup: Update Policy
 func TestAccSpotinstElastigroupAzure_UpdatePolicy(t *testing.T) {
 	groupName := "eg-azure-update-policy"
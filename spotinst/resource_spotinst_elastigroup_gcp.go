@@ -19,6 +19,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
 	"log"
+	"strings"
 	"time"
 )
 
@@ -32,7 +33,7 @@ func resourceSpotinstElastigroupGCP() *schema.Resource {
 		Delete: resourceSpotinstElastigroupGCPDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.ElastigroupGCPResourceName, ElastigroupIDPrefix, lookupElastigroupGCPByName),
 		},
 
 		Schema: commons.ElastigroupGCPResource.GetSchemaMap(),
@@ -184,10 +185,22 @@ func resourceSpotinstElastigroupGCPUpdate(resourceData *schema.ResourceData, met
 }
 
 // updateGCPGroup sends the update request to the Spotinst API and returns an error if the request fails.
+// When the update policy asks for it, the group is rolled after the update is applied.
 func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &gcp.UpdateGroupInput{Group: elastigroup}
+	var shouldRoll = false
 	groupId := resourceData.Id()
 
+	if updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_gcp.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if list != nil && len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if roll, ok := m[string(elastigroup_gcp.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+		}
+	}
+
 	if json, err := commons.ToJson(elastigroup); err != nil {
 		return err
 	} else {
@@ -196,8 +209,103 @@ func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, m
 
 	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(context.Background(), input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
+	} else if shouldRoll {
+		if err := rollGCPGroup(resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_gcp.ShouldRoll))
+	}
+
+	return nil
+}
+
+func rollGCPGroup(resourceData *schema.ResourceData, meta interface{}) error {
+	var errResult error = nil
+	groupId := resourceData.Id()
+
+	if updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_gcp.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if list != nil && len(list) > 0 && list[0] != nil {
+			updateGroupSchema := list[0].(map[string]interface{})
+			if rollConfig, ok := updateGroupSchema[string(elastigroup_gcp.RollConfig)]; !ok || rollConfig == nil || len(rollConfig.([]interface{})) == 0 {
+				errResult = fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for group [%v]", string(elastigroup_gcp.RollConfig), groupId)
+			} else {
+				if rollGroupInput, err := expandElastigroupGCPRollConfig(rollConfig, spotinst.String(groupId)); err != nil {
+					errResult = fmt.Errorf("[ERROR] onRoll() -> Failed expanding roll configuration for group [%v], error: %v", groupId, err)
+				} else {
+					if json, err := commons.ToJson(rollConfig); err != nil {
+						return err
+					} else {
+						log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupId, json)
+						errResult = resource.Retry(time.Minute*5, func() *resource.RetryError {
+							rollGroupInput.GroupID = spotinst.String(groupId)
+							rollOut, err := meta.(*Client).elastigroup.CloudProviderGCP().Roll(context.Background(), rollGroupInput)
+							if err != nil {
+								// checks whether to retry role
+								if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+									for _, err := range errs {
+										if strings.Contains(err.Code, "CANT_ROLL_CAPACITY_BELOW_MINIMUM") {
+											time.Sleep(time.Minute)
+											return resource.RetryableError(err)
+										}
+									}
+								}
+								// Some other error, report it.
+								return resource.NonRetryableError(err)
+							}
+
+							if err := awaitReadyGCPRoll(groupId, rollConfig, rollOut, meta.(*Client)); err != nil {
+								return resource.NonRetryableError(err)
+							}
+							log.Printf("onRoll() -> Successfully rolled group [%v]", groupId)
+							return nil
+						})
+					}
+				}
+			}
+		}
+	} else {
+		errResult = fmt.Errorf("[ERROR] onRoll() -> Missing update policy for group [%v]", groupId)
+	}
+
+	return errResult
+}
+
+// awaitReadyGCPRoll waits until the roll reaches wait_for_roll_percentage, or fails
+// once wait_for_roll_timeout elapses. Both must be set for the wait to happen.
+func awaitReadyGCPRoll(groupId string, rollConfig interface{}, rollOut *gcp.RollGroupOutput, client *Client) error {
+	pctTimeout := spotinst.IntValue(getGCPRollConfigInt(rollConfig, elastigroup_gcp.WaitForRollTimeout))
+	pctComplete := spotinst.IntValue(getGCPRollConfigInt(rollConfig, elastigroup_gcp.WaitForRollPct))
+	rollId := spotinst.StringValue(getGCPRollStatus(rollOut))
+
+	if pctTimeout <= 0 || pctComplete <= 0 || rollId == "" {
+		return nil
+	}
+
+	deployStatusInput := &gcp.DeploymentStatusInput{GroupID: spotinst.String(groupId), RollID: spotinst.String(rollId)}
+	err := resource.Retry(time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
+		rollStatus, err := client.elastigroup.CloudProviderGCP().DeploymentStatus(context.Background(), deployStatusInput)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitReadyGCPRoll() -> Roll group status [%v] API call failed, error: %v", groupId, err))
+		}
+
+		progress := 0
+		if len(rollStatus.RollGroupStatus) > 0 && rollStatus.RollGroupStatus[0].Progress != nil {
+			progress = spotinst.IntValue(rollStatus.RollGroupStatus[0].Progress.Value)
+		}
+		if progress < pctComplete {
+			log.Printf("===> waiting for at least %d%% of batches to complete, currently %d%% <===\n", pctComplete, progress)
+			return resource.RetryableError(fmt.Errorf("===> roll at %v%% complete <===", progress))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Did not reach target deployment amount. Message: %s", err)
 	}
 
+	log.Printf("awaitReadyGCPRoll() -> Target deployment percentage reached [%v]", groupId)
 	return nil
 }
 
@@ -236,3 +344,50 @@ func deleteGCPGroup(resourceData *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//         Fields Expand
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func expandElastigroupGCPRollConfig(data interface{}, groupID *string) (*gcp.RollGroupInput, error) {
+	i := &gcp.RollGroupInput{GroupID: groupID}
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(elastigroup_gcp.BatchSizePercentage)].(int); ok { // Required value
+			i.BatchSizePercentage = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp.GracePeriod)].(int); ok && v != -1 { // Default value set to -1
+			i.GracePeriod = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp.HealthCheckType)].(string); ok && v != "" {
+			i.HealthCheckType = spotinst.String(v)
+		}
+	}
+	return i, nil
+}
+
+func getGCPRollConfigInt(data interface{}, fieldName commons.FieldName) *int {
+	var value *int
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(fieldName)].(int); ok {
+			value = spotinst.Int(v)
+		}
+	}
+	return value
+}
+
+func getGCPRollStatus(rollOut *gcp.RollGroupOutput) *string {
+	for item := range rollOut.RollGroupStatus {
+		rs := strings.ToUpper(spotinst.StringValue(rollOut.RollGroupStatus[item].RollStatus))
+		if rs == "IN_PROGRESS" || rs == "STARTING" {
+			return rollOut.RollGroupStatus[item].RollID
+		}
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
-	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_launch_configuration"
 	"log"
 	"testing"
 )
@@ -329,7 +328,7 @@ func TestAccSpotinstElastigroupGCP_LaunchConfiguration(t *testing.T) {
 					testCheckElastigroupGCPExists(&group, resourceName),
 					testCheckElastigroupGCPAttributes(&group, groupName),
 					resource.TestCheckResourceAttr(resourceName, "service_account", "265168459660-compute@developer.gserviceaccount.com"),
-					resource.TestCheckResourceAttr(resourceName, "startup_script", elastigroup_gcp_launch_configuration.HexStateFunc("echo hello world")),
+					resource.TestCheckResourceAttr(resourceName, "startup_script", commons.UserDataStateFunc("echo hello world")),
 					resource.TestCheckResourceAttr(resourceName, "backend_services.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash_create+".named_ports.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash_create+".service_name", "terraform-acc-test-backend-service"),
@@ -359,7 +358,7 @@ func TestAccSpotinstElastigroupGCP_LaunchConfiguration(t *testing.T) {
 					testCheckElastigroupGCPExists(&group, resourceName),
 					testCheckElastigroupGCPAttributes(&group, groupName),
 					resource.TestCheckResourceAttr(resourceName, "service_account", "terraform-acc-test-account@spotinst-labs.iam.gserviceaccount.com"),
-					resource.TestCheckResourceAttr(resourceName, "startup_script", elastigroup_gcp_launch_configuration.HexStateFunc("echo hello world updated")),
+					resource.TestCheckResourceAttr(resourceName, "startup_script", commons.UserDataStateFunc("echo hello world updated")),
 					resource.TestCheckResourceAttr(resourceName, "backend_services.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash1_update+".service_name", "terraform-acc-test-backend-service"),
 					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash1_update+".named_ports.#", "1"),
@@ -396,7 +395,7 @@ func TestAccSpotinstElastigroupGCP_LaunchConfiguration(t *testing.T) {
 					testCheckElastigroupGCPExists(&group, resourceName),
 					testCheckElastigroupGCPAttributes(&group, groupName),
 					resource.TestCheckResourceAttr(resourceName, "service_account", "cannot set empty service account"),
-					resource.TestCheckResourceAttr(resourceName, "startup_script", elastigroup_gcp_launch_configuration.HexStateFunc("cannot set empty startup script")),
+					resource.TestCheckResourceAttr(resourceName, "startup_script", commons.UserDataStateFunc("cannot set empty startup script")),
 					resource.TestCheckResourceAttr(resourceName, "ip_forwarding", "false"),
 					resource.TestCheckResourceAttr(resourceName, "labels.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.#", "0"),
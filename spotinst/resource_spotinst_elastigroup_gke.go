@@ -8,6 +8,13 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_disk"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_gpu"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_integrations"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_launch_configuration"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_network_interface"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gke"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/elastigroup_gke_instance_types"
 	"log"
@@ -23,7 +30,7 @@ func resourceSpotinstElastigroupGKE() *schema.Resource {
 		Delete: resourceSpotinstElastigroupGKEDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.ElastigroupGKEResourceName, ElastigroupIDPrefix, lookupElastigroupGCPByName),
 		},
 
 		Schema: commons.ElastigroupGKEResource.GetSchemaMap(),
@@ -31,9 +38,18 @@ func resourceSpotinstElastigroupGKE() *schema.Resource {
 }
 
 // setupElastigroupGKEResource calls the setup function for each of the children blocks.
+// The GKE fields are set up last, since they replace the GCP fields sharing their name.
 func setupElastigroupGKEResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
+	elastigroup_gcp_disk.Setup(fieldsMap)
+	elastigroup_gcp_gpu.Setup(fieldsMap)
+	elastigroup_gcp_integrations.SetupGKE(fieldsMap)
+	elastigroup_gcp_launch_configuration.Setup(fieldsMap)
+	elastigroup_gcp_network_interface.Setup(fieldsMap)
+	elastigroup_gcp_scaling_policies.Setup(fieldsMap)
+	elastigroup_gcp_strategy.Setup(fieldsMap)
+
 	elastigroup_gke.Setup(fieldsMap)
 	elastigroup_gke_instance_types.Setup(fieldsMap)
 
@@ -43,11 +59,11 @@ func setupElastigroupGKEResource() {
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //     Import GKE Group
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
-func importGKEGroup(resourceData *schema.ResourceData, meta interface{}) (*gcp.Group, error) {
+func importGKEGroup(resourceData *schema.ResourceData, meta interface{}) (*gcp.Group, *gcp.Group, error) {
 
-	group, err := commons.ElastigroupGKEResource.OnCreate(resourceData, meta)
+	group, update, err := commons.ElastigroupGKEResource.OnCreate(resourceData, meta)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	input := &gcp.ImportGKEClusterInput{
@@ -65,15 +81,15 @@ func importGKEGroup(resourceData *schema.ResourceData, meta interface{}) (*gcp.G
 			for _, err := range errs {
 				if err.Code == ErrCodeGroupNotFound {
 					resourceData.SetId("")
-					return nil, err
+					return nil, nil, err
 				}
 			}
 		}
 		// Some other error, report it.
-		return nil, fmt.Errorf("GKE:IMPORT failed to read group: %s", err)
+		return nil, nil, fmt.Errorf("GKE:IMPORT failed to read group: %s", err)
 	}
 
-	return resp.Group, err
+	return resp.Group, update, err
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -86,7 +102,7 @@ func resourceSpotinstElastigroupGKECreate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.ElastigroupGKEResource.GetName())
 
-	gkeGroup, err := importGKEGroup(resourceData, meta.(*Client))
+	gkeGroup, update, err := importGKEGroup(resourceData, meta.(*Client))
 	if err != nil {
 		return err
 	}
@@ -96,6 +112,14 @@ func resourceSpotinstElastigroupGKECreate(resourceData *schema.ResourceData, met
 	}
 
 	resourceData.SetId(spotinst.StringValue(gkeGroup.ID))
+
+	// Fields such as disks or backend services cannot be set by the import request
+	if update != nil {
+		update.SetID(gkeGroup.ID)
+		if err := updateGKEGroup(update, resourceData, meta); err != nil {
+			return err
+		}
+	}
 	log.Printf("===> Elastigroup for GKE created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstElastigroupGKERead(resourceData, meta)
 }
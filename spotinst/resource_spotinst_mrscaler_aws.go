@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/mrscaler_aws_scheduled_task"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/mrscaler_aws_strategy"
 	"log"
+	"sort"
 	"strings"
 	"time"
 )
@@ -30,9 +31,11 @@ func resourceSpotinstMRScalerAWS() *schema.Resource {
 		Delete: resourceSpotinstMRScalerAWSDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMRScalerAWS,
 		},
 
+		CustomizeDiff: validateMRScalerAWSStrategy,
+
 		Schema: commons.MRScalerAWSResource.GetSchemaMap(),
 	}
 }
@@ -70,6 +73,12 @@ func resourceSpotinstMRScalerAWSCreate(resourceData *schema.ResourceData, meta i
 
 	resourceData.SetId(spotinst.StringValue(scalerId))
 
+	if v, ok := resourceData.GetOk(string(mrscaler_aws.WaitForCluster)); ok {
+		if err := awaitMRScalerCluster(resourceData.Id(), v, meta.(*Client)); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("===> MRScaler created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstMRScalerAWSRead(resourceData, meta)
@@ -147,6 +156,40 @@ func resourceSpotinstMRScalerAWSRead(resourceData *schema.ResourceData, meta int
 	return nil
 }
 
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Import
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// importMRScalerAWS resolves the scaler by ID or name and builds its state,
+// strategy included, from the scaler and the EMR cluster it manages.
+func importMRScalerAWS(resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importState := importStateByName(commons.MRScalerAWSResourceName, MRScalerIDPrefix, lookupMRScalerAWSByName)
+	results, err := importState(resourceData, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	id := resourceData.Id()
+	input := &mrscaler.ReadScalerInput{ScalerID: spotinst.String(id)}
+	resp, err := meta.(*Client).mrscaler.Read(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] onImport() -> Failed to read scaler [%v]: %v", id, err)
+	}
+	if resp.Scaler == nil {
+		return nil, fmt.Errorf("[ERROR] onImport() -> No %s found with ID [%v]", commons.MRScalerAWSResourceName, id)
+	}
+
+	if err := commons.MRScalerAWSResource.OnRead(resp.Scaler, resourceData, meta); err != nil {
+		return nil, err
+	}
+	if err := exposeMrScalerClusterId(resourceData, meta); err != nil {
+		return nil, err
+	}
+
+	log.Printf("===> MRScaler imported successfully: %s <===", id)
+	return results, nil
+}
+
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //            Update
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -199,6 +242,10 @@ func resourceSpotinstMRScalerAWSDelete(resourceData *schema.ResourceData, meta i
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MRScalerAWSResource.GetName(), id)
 
+	if err := checkMRScalerAWSDeletion(resourceData, meta.(*Client)); err != nil {
+		return err
+	}
+
 	if err := deleteScaler(resourceData, meta); err != nil {
 		return err
 	}
@@ -226,10 +273,163 @@ func deleteScaler(resourceData *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// checkMRScalerAWSDeletion protects the cluster a scaler terminates on destroy.
+// Unless force_destroy is set, a termination protected cluster is kept, and so
+// is one with active steps once drain_steps_timeout has passed. Wrapped
+// clusters are never terminated, so they are not checked.
+func checkMRScalerAWSDeletion(resourceData *schema.ResourceData, spotinstClient *Client) error {
+	scalerId := resourceData.Id()
+	if resourceData.Get(string(mrscaler_aws.ForceDestroy)).(bool) ||
+		resourceData.Get(string(mrscaler_aws_strategy.Strategy)).(string) == mrscaler_aws_strategy.Wrap {
+		return nil
+	}
+
+	if resourceData.Get(string(mrscaler_aws_cluster.TerminationProtected)).(bool) {
+		return fmt.Errorf("[ERROR] Scaler [%v] has %s set, set %s to destroy it",
+			scalerId, mrscaler_aws_cluster.TerminationProtected, mrscaler_aws.ForceDestroy)
+	}
+
+	input := &mrscaler.ListScalerClusterStepsInput{ScalerID: spotinst.String(scalerId)}
+	listActiveSteps := func() ([]string, error) {
+		resp, err := spotinstClient.mrscaler.ListScalerClusterSteps(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Failed to list the cluster steps of scaler [%v]: %v", scalerId, err)
+		}
+
+		var active []string
+		for _, step := range resp.Steps {
+			switch strings.ToUpper(spotinst.StringValue(step.State)) {
+			case "PENDING", "RUNNING", "CANCEL_PENDING":
+				active = append(active, spotinst.StringValue(step.Name))
+			}
+		}
+		return active, nil
+	}
+
+	active, err := listActiveSteps()
+	if err != nil {
+		return err
+	}
+
+	if timeout := resourceData.Get(string(mrscaler_aws.DrainStepsTimeout)).(int); len(active) > 0 && timeout > 0 {
+		err = resource.Retry(time.Second*time.Duration(timeout), func() *resource.RetryError {
+			if active, err = listActiveSteps(); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if len(active) == 0 {
+				return nil
+			}
+
+			log.Printf("===> waiting for %d active steps of scaler [%v] to drain <===", len(active), scalerId)
+			return resource.RetryableError(fmt.Errorf("===> %d active steps <===", len(active)))
+		})
+		if err != nil && len(active) == 0 {
+			return err
+		}
+	}
+
+	if len(active) > 0 {
+		return fmt.Errorf("[ERROR] Scaler [%v] has active steps: %s. Set %s to destroy it anyway, or %s to wait for them",
+			scalerId, strings.Join(active, ", "), mrscaler_aws.ForceDestroy, mrscaler_aws.DrainStepsTimeout)
+	}
+	return nil
+}
+
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //            Utils
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 
+// mrScalerAWSNewOnlyFields configure the EMR cluster itself, so only a
+// cluster created by the scaler accepts them.
+var mrScalerAWSNewOnlyFields = []commons.FieldName{
+	mrscaler_aws_cluster.LogURI,
+	mrscaler_aws_cluster.AdditionalInfo,
+	mrscaler_aws_cluster.JobFlowRole,
+	mrscaler_aws_cluster.SecurityConfig,
+	mrscaler_aws_cluster.ServiceRole,
+	mrscaler_aws_cluster.VisibleToAllUsers,
+	mrscaler_aws_cluster.TerminationProtected,
+	mrscaler_aws_cluster.KeepJobFlowAlive,
+	mrscaler_aws.EBSRootVolumeSize,
+	mrscaler_aws.ManagedPrimarySecurityGroup,
+	mrscaler_aws.ManagedReplicaSecurityGroup,
+	mrscaler_aws.ServiceAccessSecurityGroup,
+	mrscaler_aws.AddlPrimarySecurityGroups,
+	mrscaler_aws.AddlReplicaSecurityGroups,
+	mrscaler_aws.CustomAMIID,
+	mrscaler_aws.RepoUpgradeOnBoot,
+	mrscaler_aws.EC2KeyName,
+	mrscaler_aws.Applications,
+}
+
+// mrScalerAWSComputeFields describe the compute of a cluster launched by the
+// scaler. A wrapped cluster keeps its own compute and only its task group is
+// managed.
+var mrScalerAWSComputeFields = []commons.FieldName{
+	mrscaler_aws_strategy.ReleaseLabel,
+	mrscaler_aws_strategy.Retries,
+	mrscaler_aws_strategy.ProvisioningTimeout,
+	mrscaler_aws.WaitForCluster,
+	mrscaler_aws.AvailabilityZones,
+	mrscaler_aws.Tags,
+	mrscaler_aws.ConfigurationsFile,
+	mrscaler_aws.Configurations,
+	mrscaler_aws.BootstrapActionsFile,
+	mrscaler_aws.BootstrapActions,
+	mrscaler_aws.StepsFile,
+	mrscaler_aws.Steps,
+	mrscaler_aws_instance_groups.MasterInstanceTypes,
+	mrscaler_aws_instance_groups.MasterLifecycle,
+	mrscaler_aws_instance_groups.MasterEBSOptimized,
+	mrscaler_aws_instance_groups.MasterEBSBlockDevice,
+	mrscaler_aws_instance_groups.CoreInstanceTypes,
+	mrscaler_aws_instance_groups.CoreInstanceTypeConfig,
+	mrscaler_aws_instance_groups.CoreMin,
+	mrscaler_aws_instance_groups.CoreMax,
+	mrscaler_aws_instance_groups.CoreTarget,
+	mrscaler_aws_instance_groups.CoreLifecycle,
+	mrscaler_aws_instance_groups.CoreEBSOptimized,
+	mrscaler_aws_instance_groups.CoreEBSBlockDevice,
+	mrscaler_aws_scaling_policies.CoreScalingUpPolicy,
+	mrscaler_aws_scaling_policies.CoreScalingDownPolicy,
+}
+
+// validateMRScalerAWSStrategy rejects the fields the chosen strategy ignores,
+// and makes sure clone and wrap name the cluster they start from. It runs on
+// the diff, so a mismatch fails the plan.
+func validateMRScalerAWSStrategy(diff *schema.ResourceDiff, meta interface{}) error {
+	strategy := diff.Get(string(mrscaler_aws_strategy.Strategy)).(string)
+	_, hasClusterID := diff.GetOk(string(mrscaler_aws.ClusterID))
+
+	var forbidden []commons.FieldName
+	switch strategy {
+	case mrscaler_aws_strategy.New:
+		if hasClusterID {
+			return fmt.Errorf("[ERROR] %s strategy does not support %s", strategy, mrscaler_aws.ClusterID)
+		}
+	case mrscaler_aws_strategy.Clone:
+		forbidden = mrScalerAWSNewOnlyFields
+	case mrscaler_aws_strategy.Wrap:
+		forbidden = append(append(forbidden, mrScalerAWSNewOnlyFields...), mrScalerAWSComputeFields...)
+	}
+
+	if strategy != mrscaler_aws_strategy.New && !hasClusterID {
+		return fmt.Errorf("[ERROR] %s strategy requires %s", strategy, mrscaler_aws.ClusterID)
+	}
+
+	var invalid []string
+	for _, field := range forbidden {
+		if _, ok := diff.GetOk(string(field)); ok {
+			invalid = append(invalid, string(field))
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("[ERROR] %s strategy does not support: %s", strategy, strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
 func exposeMrScalerClusterId(resourceData *schema.ResourceData, meta interface{}) error {
 	spotinstClient := meta.(*Client)
 	input := &mrscaler.ScalerClusterStatusInput{ScalerID: spotinst.String(resourceData.Id())}
@@ -244,3 +444,56 @@ func exposeMrScalerClusterId(resourceData *schema.ResourceData, meta interface{}
 	}
 	return nil
 }
+
+// awaitMRScalerCluster polls the EMR cluster of a scaler until it reaches the
+// target state. A RUNNING target is also satisfied by a WAITING cluster.
+func awaitMRScalerCluster(scalerId string, waitConfig interface{}, spotinstClient *Client) error {
+	targetState, timeout := "RUNNING", 0
+	if list := waitConfig.([]interface{}); len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+		if v, ok := m[string(mrscaler_aws.WaitForClusterTargetState)].(string); ok && v != "" {
+			targetState = v
+		}
+		if v, ok := m[string(mrscaler_aws.WaitForClusterTimeout)].(int); ok {
+			timeout = v
+		}
+	}
+	if timeout <= 0 {
+		return nil
+	}
+
+	input := &mrscaler.ScalerClusterStatusInput{ScalerID: spotinst.String(scalerId)}
+	err := resource.Retry(time.Second*time.Duration(timeout), func() *resource.RetryError {
+		resp, err := spotinstClient.mrscaler.ReadScalerCluster(context.Background(), input)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitMRScalerCluster() -> Cluster status of scaler [%v] API call failed, error: %v", scalerId, err))
+		}
+
+		state := strings.ToUpper(spotinst.StringValue(resp.State))
+		switch state {
+		case "TERMINATING", "TERMINATED", "TERMINATED_WITH_ERRORS":
+			reason := "unknown reason"
+			if r := resp.StateChangeReason; r != nil {
+				reason = fmt.Sprintf("%s: %s", spotinst.StringValue(r.Code), spotinst.StringValue(r.Message))
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] EMR cluster [%v] of scaler [%v] is %s (%s)",
+				spotinst.StringValue(resp.ScalerClusterId), scalerId, state, reason))
+		case targetState:
+			return nil
+		case "WAITING":
+			if targetState == "RUNNING" {
+				return nil
+			}
+		}
+
+		log.Printf("===> waiting for the EMR cluster of scaler [%v] to be %s, currently %q <===\n", scalerId, targetState, state)
+		return resource.RetryableError(fmt.Errorf("===> cluster is %q <===", state))
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] EMR cluster of scaler [%v] did not reach state %s. Message: %s", scalerId, targetState, err)
+	}
+
+	log.Printf("awaitMRScalerCluster() -> EMR cluster of scaler [%v] is %s", scalerId, targetState)
+	return nil
+}
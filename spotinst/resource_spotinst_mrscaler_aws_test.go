@@ -237,6 +237,7 @@ resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
  availability_zones = ["us-west-2b:subnet-1ba25052"]
  strategy           = "%v"
  region             = "us-west-2"
+ force_destroy      = true
 
  %v
  %v
@@ -256,6 +257,7 @@ resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
  availability_zones = ["us-west-2b:subnet-1ba25052"]
  strategy           = "%v"
  region             = "us-west-2"
+ force_destroy      = true
 
  %v
  %v
@@ -1784,6 +1786,7 @@ resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
  availability_zones = ["us-west-2b:subnet-1ba25052"]
  strategy           = "%v"
  region             = "us-west-2"
+ force_destroy      = true
  cluster_id         = "%v"
 
  %v
@@ -1803,6 +1806,7 @@ resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
  availability_zones = ["us-west-2b:subnet-1ba25052"]
  strategy           = "%v"
  region             = "us-west-2"
+ force_destroy      = true
  cluster_id         = "%v"
 
  %v
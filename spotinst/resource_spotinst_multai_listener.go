@@ -23,7 +23,7 @@ func resourceSpotinstMultaiListener() *schema.Resource {
 		Delete: resourceSpotinstMultaiListenerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByCompositeID(multai_listener.BalancerID),
 		},
 
 		Schema: commons.MultaiListenerResource.GetSchemaMap(),
@@ -23,7 +23,7 @@ func resourceSpotinstMultaiRoutingRule() *schema.Resource {
 		Delete: resourceSpotinstMultaiRoutingRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByCompositeID(multai_routing_rule.BalancerID),
 		},
 
 		Schema: commons.MultaiRoutingRuleResource.GetSchemaMap(),
@@ -23,7 +23,7 @@ func resourceSpotinstMultaiTarget() *schema.Resource {
 		Delete: resourceSpotinstMultaiTargetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByCompositeID(multai_target.BalancerID, multai_target.TargetSetID),
 		},
 
 		Schema: commons.MultaiTargetResource.GetSchemaMap(),
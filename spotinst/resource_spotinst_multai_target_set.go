@@ -23,7 +23,7 @@ func resourceSpotinstMultaiTargetSet() *schema.Resource {
 		Delete: resourceSpotinstMultaiTargetSetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByCompositeID(multai_target_set.BalancerID),
 		},
 
 		Schema: commons.MultaiTargetSetResource.GetSchemaMap(),
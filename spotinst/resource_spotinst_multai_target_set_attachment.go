@@ -0,0 +1,489 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	MultaiTargetSetAttachmentBalancerId    commons.FieldName = "balancer_id"
+	MultaiTargetSetAttachmentTargetSetId   commons.FieldName = "target_set_id"
+	MultaiTargetSetAttachmentTargets       commons.FieldName = "targets"
+	MultaiTargetSetAttachmentElastigroupId commons.FieldName = "elastigroup_id"
+	MultaiTargetSetAttachmentPort          commons.FieldName = "port"
+	MultaiTargetSetAttachmentWeight        commons.FieldName = "weight"
+	MultaiTargetSetAttachmentDrainTimeout  commons.FieldName = "drain_timeout"
+	MultaiTargetSetAttachmentAdoptExisting commons.FieldName = "adopt_existing"
+	MultaiTargetSetAttachmentTargetIds     commons.FieldName = "target_ids"
+)
+
+// drainSleep waits for drained targets to finish their connections.
+var drainSleep = time.Sleep
+
+// resourceSpotinstMultaiTargetSetAttachment registers a target in a target
+// set for every host:port entry, or for every instance of an Elastigroup.
+// Targets are tracked by host:port, so only the entries that change are
+// registered or removed, and only the targets it registered are removed.
+func resourceSpotinstMultaiTargetSetAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSpotinstMultaiTargetSetAttachmentCreate,
+		Read:   resourceSpotinstMultaiTargetSetAttachmentRead,
+		Update: resourceSpotinstMultaiTargetSetAttachmentUpdate,
+		Delete: resourceSpotinstMultaiTargetSetAttachmentDelete,
+
+		CustomizeDiff: resourceSpotinstMultaiTargetSetAttachmentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			string(MultaiTargetSetAttachmentBalancerId): {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			string(MultaiTargetSetAttachmentTargetSetId): {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			string(MultaiTargetSetAttachmentTargets): {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+						if _, _, err := splitTargetAddress(v.(string)); err != nil {
+							return nil, []error{fmt.Errorf("%q: %s", k, err)}
+						}
+						return nil, nil
+					},
+				},
+				Optional:      true,
+				ConflictsWith: []string{string(MultaiTargetSetAttachmentElastigroupId)},
+			},
+
+			string(MultaiTargetSetAttachmentElastigroupId): {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{string(MultaiTargetSetAttachmentTargets)},
+			},
+
+			string(MultaiTargetSetAttachmentPort): {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			string(MultaiTargetSetAttachmentWeight): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if v.(int) < 1 {
+						return nil, []error{fmt.Errorf("%q must be at least 1, got %d", k, v.(int))}
+					}
+					return nil, nil
+				},
+			},
+
+			string(MultaiTargetSetAttachmentDrainTimeout): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			string(MultaiTargetSetAttachmentAdoptExisting): {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			string(MultaiTargetSetAttachmentTargetIds): {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceSpotinstMultaiTargetSetAttachmentCustomizeDiff compares the tracked
+// targets with targets, or with the instances of elastigroup_id, and marks
+// them as changing when they drifted, so the plan runs an update to
+// reconcile them.
+func resourceSpotinstMultaiTargetSetAttachmentCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// A configuration change runs an update, which reconciles anyway.
+	for _, fieldName := range []commons.FieldName{
+		MultaiTargetSetAttachmentTargets,
+		MultaiTargetSetAttachmentElastigroupId,
+		MultaiTargetSetAttachmentPort,
+	} {
+		if diff.HasChange(string(fieldName)) {
+			return nil
+		}
+	}
+
+	desired, err := expandTargetSetAttachmentTargets(diff.GetOk, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	tracked := diff.Get(string(MultaiTargetSetAttachmentTargetIds)).(map[string]interface{})
+	inSync := len(desired) == len(tracked)
+	for address := range desired {
+		if _, ok := tracked[address]; !ok {
+			inSync = false
+		}
+	}
+	if !inSync {
+		log.Printf("===> Targets of %s are out of sync <===", diff.Id())
+		return diff.SetNewComputed(string(MultaiTargetSetAttachmentTargetIds))
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Create
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstMultaiTargetSetAttachmentCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), string(commons.MultaiTargetSetAttachmentResourceName))
+
+	desired, err := expandTargetSetAttachmentTargets(resourceData.GetOk, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(resource.PrefixedUniqueId(resourceData.Get(string(MultaiTargetSetAttachmentTargetSetId)).(string) + "-"))
+	if err := reconcileTargetSetAttachment(resourceData, meta.(*Client), desired); err != nil {
+		return err
+	}
+
+	log.Printf("===> Target set attachment created successfully: %s <===", resourceData.Id())
+	return resourceSpotinstMultaiTargetSetAttachmentRead(resourceData, meta)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Read
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstMultaiTargetSetAttachmentRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), string(commons.MultaiTargetSetAttachmentResourceName), id)
+
+	client := meta.(*Client)
+	existing, err := listTargetSetTargets(resourceData, client)
+	if err != nil {
+		return err
+	}
+
+	tracked := targetSetAttachmentTracked(resourceData)
+	found := make(map[string]interface{}, len(tracked))
+	for address, targetId := range tracked {
+		target, ok := existing[targetId]
+		if !ok {
+			continue
+		}
+		found[address] = targetId
+
+		// Every target shares the weight, so any one that drifted is
+		// reported as the attachment drifting.
+		if weight := spotinst.IntValue(target.Weight); weight != resourceData.Get(string(MultaiTargetSetAttachmentWeight)).(int) {
+			if err := resourceData.Set(string(MultaiTargetSetAttachmentWeight), weight); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiTargetSetAttachmentWeight), err)
+			}
+		}
+	}
+
+	// Targets deregistered outside Terraform are dropped from the state, so
+	// the next plan registers them again.
+	if err := resourceData.Set(string(MultaiTargetSetAttachmentTargetIds), found); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiTargetSetAttachmentTargetIds), err)
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Update
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstMultaiTargetSetAttachmentUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), string(commons.MultaiTargetSetAttachmentResourceName), id)
+
+	desired, err := expandTargetSetAttachmentTargets(resourceData.GetOk, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if err := reconcileTargetSetAttachment(resourceData, meta.(*Client), desired); err != nil {
+		return err
+	}
+
+	log.Printf("===> Target set attachment updated successfully: %s <===", id)
+	return resourceSpotinstMultaiTargetSetAttachmentRead(resourceData, meta)
+}
+
+// reconcileTargetSetAttachment registers the desired targets that are missing,
+// drains and removes the tracked targets that are no longer desired, and sets
+// the weight of the rest. A target registered by someone else at a desired
+// host:port is only taken over with adopt_existing. The tracked targets are
+// saved even when it fails part way, so the next apply picks up where this
+// one stopped.
+func reconcileTargetSetAttachment(resourceData *schema.ResourceData, client *Client, desired map[string]bool) (err error) {
+	// The plan marks the targets as computed when they need reconciling, so
+	// start from the ones in the prior state.
+	previous, _ := resourceData.GetChange(string(MultaiTargetSetAttachmentTargetIds))
+	tracked := previous.(map[string]interface{})
+	defer func() {
+		if setErr := resourceData.Set(string(MultaiTargetSetAttachmentTargetIds), tracked); setErr != nil && err == nil {
+			err = fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultaiTargetSetAttachmentTargetIds), setErr)
+		}
+	}()
+
+	existing, err := listTargetSetTargets(resourceData, client)
+	if err != nil {
+		return err
+	}
+	for address, targetId := range tracked {
+		if _, ok := existing[targetId.(string)]; !ok {
+			delete(tracked, address)
+		}
+	}
+
+	adopt := resourceData.Get(string(MultaiTargetSetAttachmentAdoptExisting)).(bool)
+	for _, targetId := range sortedTargetIds(existing) {
+		target := existing[targetId]
+		address := net.JoinHostPort(spotinst.StringValue(target.Host), strconv.Itoa(spotinst.IntValue(target.Port)))
+		if _, ok := tracked[address]; ok || !desired[address] {
+			continue
+		}
+		if !adopt {
+			return fmt.Errorf("[ERROR] Target %s is already registered at %s; set %s to manage it",
+				targetId, address, MultaiTargetSetAttachmentAdoptExisting)
+		}
+		log.Printf("===> Adopting target %s registered at %s <===", targetId, address)
+		tracked[address] = targetId
+	}
+
+	var removed, added, kept []string
+	for address := range tracked {
+		if !desired[address] {
+			removed = append(removed, address)
+		}
+	}
+	for address := range desired {
+		if _, ok := tracked[address]; ok {
+			kept = append(kept, address)
+		} else {
+			added = append(added, address)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	sort.Strings(kept)
+	log.Printf("===> Target set attachment %s: %d to register, %d to remove, %d unchanged",
+		resourceData.Id(), len(added), len(removed), len(kept))
+
+	if err := removeTargetSetAttachmentTargets(resourceData, client, tracked, removed); err != nil {
+		return err
+	}
+
+	weight := resourceData.Get(string(MultaiTargetSetAttachmentWeight)).(int)
+	for _, address := range added {
+		host, port, _ := splitTargetAddress(address)
+		target := &multai.Target{}
+		target.SetBalancerId(spotinst.String(resourceData.Get(string(MultaiTargetSetAttachmentBalancerId)).(string)))
+		target.SetTargetSetId(spotinst.String(resourceData.Get(string(MultaiTargetSetAttachmentTargetSetId)).(string)))
+		target.SetName(spotinst.String(address))
+		target.SetHost(spotinst.String(host))
+		target.SetPort(spotinst.Int(port))
+		target.SetWeight(spotinst.Int(weight))
+
+		targetId, err := createTarget(target, client)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Failed to register target %s: %s", address, err)
+		}
+		tracked[address] = spotinst.StringValue(targetId)
+	}
+
+	for _, address := range kept {
+		targetId := tracked[address].(string)
+		if spotinst.IntValue(existing[targetId].Weight) == weight {
+			continue
+		}
+		if err := setTargetWeight(client, targetId, weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Delete
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstMultaiTargetSetAttachmentDelete(resourceData *schema.ResourceData, meta interface{}) (err error) {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), string(commons.MultaiTargetSetAttachmentResourceName), id)
+
+	tracked := make(map[string]interface{})
+	addresses := make([]string, 0)
+	for address, targetId := range targetSetAttachmentTracked(resourceData) {
+		tracked[address] = targetId
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	if err := removeTargetSetAttachmentTargets(resourceData, meta.(*Client), tracked, addresses); err != nil {
+		if setErr := resourceData.Set(string(MultaiTargetSetAttachmentTargetIds), tracked); setErr != nil {
+			log.Printf("[ERROR] Failed to save the remaining targets of %s: %s", id, setErr)
+		}
+		return err
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+
+// removeTargetSetAttachmentTargets removes the targets at addresses from
+// tracked. With a drain_timeout, their weight is set to 0 first and they are
+// removed once the timeout has passed, letting open connections finish.
+func removeTargetSetAttachmentTargets(resourceData *schema.ResourceData, client *Client, tracked map[string]interface{}, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	if timeout := resourceData.Get(string(MultaiTargetSetAttachmentDrainTimeout)).(int); timeout > 0 {
+		for _, address := range addresses {
+			if err := setTargetWeight(client, tracked[address].(string), 0); err != nil {
+				return err
+			}
+		}
+		log.Printf("===> Draining %d targets for %ds <===", len(addresses), timeout)
+		drainSleep(time.Duration(timeout) * time.Second)
+	}
+
+	for _, address := range addresses {
+		targetId := tracked[address].(string)
+		input := &multai.DeleteTargetInput{TargetID: spotinst.String(targetId)}
+		if _, err := client.multai.DeleteTarget(context.Background(), input); err != nil {
+			return fmt.Errorf("[ERROR] Failed to remove target %s at %s: %s", targetId, address, err)
+		}
+		delete(tracked, address)
+	}
+	return nil
+}
+
+func setTargetWeight(client *Client, targetId string, weight int) error {
+	target := &multai.Target{}
+	target.SetId(spotinst.String(targetId))
+	target.SetWeight(spotinst.Int(weight))
+
+	input := &multai.UpdateTargetInput{Target: target}
+	if _, err := client.multai.UpdateTarget(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to set the weight of target %s to %d: %s", targetId, weight, err)
+	}
+	return nil
+}
+
+// expandTargetSetAttachmentTargets returns the host:port of every desired
+// target, read from targets or from the instances of elastigroup_id. getOk
+// reads the configuration, from either the resource data or the diff.
+func expandTargetSetAttachmentTargets(getOk func(string) (interface{}, bool), client *Client) (map[string]bool, error) {
+	desired := make(map[string]bool)
+
+	groupId, ok := getOk(string(MultaiTargetSetAttachmentElastigroupId))
+	if !ok {
+		v, ok := getOk(string(MultaiTargetSetAttachmentTargets))
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] One of %s or %s must be set",
+				MultaiTargetSetAttachmentTargets, MultaiTargetSetAttachmentElastigroupId)
+		}
+		for _, address := range v.(*schema.Set).List() {
+			desired[address.(string)] = true
+		}
+		return desired, nil
+	}
+
+	port, ok := getOk(string(MultaiTargetSetAttachmentPort))
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] %s is required with %s",
+			MultaiTargetSetAttachmentPort, MultaiTargetSetAttachmentElastigroupId)
+	}
+
+	svc := client.elastigroup.CloudProviderAWS()
+	status, err := svc.Status(context.Background(), &aws.StatusGroupInput{GroupID: spotinst.String(groupId.(string))})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to get the status of group %s: %s", groupId, err)
+	}
+	for _, instance := range status.Instances {
+		// Pending spot requests have no instance, nor an address, yet.
+		if instance.ID == nil || instance.PrivateIP == nil {
+			continue
+		}
+		desired[net.JoinHostPort(spotinst.StringValue(instance.PrivateIP), strconv.Itoa(port.(int)))] = true
+	}
+	return desired, nil
+}
+
+func listTargetSetTargets(resourceData *schema.ResourceData, client *Client) (map[string]*multai.Target, error) {
+	targetSetId := resourceData.Get(string(MultaiTargetSetAttachmentTargetSetId)).(string)
+	input := &multai.ListTargetsInput{
+		BalancerID:  spotinst.String(resourceData.Get(string(MultaiTargetSetAttachmentBalancerId)).(string)),
+		TargetSetID: spotinst.String(targetSetId),
+	}
+	resp, err := client.multai.ListTargets(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to list the targets of target set %s: %s", targetSetId, err)
+	}
+
+	targets := make(map[string]*multai.Target, len(resp.Targets))
+	for _, target := range resp.Targets {
+		targets[spotinst.StringValue(target.ID)] = target
+	}
+	return targets, nil
+}
+
+func sortedTargetIds(targets map[string]*multai.Target) []string {
+	targetIds := make([]string, 0, len(targets))
+	for targetId := range targets {
+		targetIds = append(targetIds, targetId)
+	}
+	sort.Strings(targetIds)
+	return targetIds
+}
+
+// targetSetAttachmentTracked returns the target IDs in the state, keyed by host:port.
+func targetSetAttachmentTracked(resourceData *schema.ResourceData) map[string]string {
+	tracked := make(map[string]string)
+	for address, targetId := range resourceData.Get(string(MultaiTargetSetAttachmentTargetIds)).(map[string]interface{}) {
+		tracked[address] = targetId.(string)
+	}
+	return tracked
+}
+
+func splitTargetAddress(address string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil || host == "" {
+		return "", 0, fmt.Errorf("target must be host:port, got %q", address)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("target port must be between 1 and 65535, got %q", portStr)
+	}
+	return host, port, nil
+}
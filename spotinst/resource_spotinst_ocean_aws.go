@@ -29,7 +29,7 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 		Delete: resourceSpotinstClusterAWSDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName(commons.OceanAWSResourceName, OceanIDPrefix, lookupOceanAWSByName),
 		},
 		Schema: commons.OceanResource.GetSchemaMap(),
 	}
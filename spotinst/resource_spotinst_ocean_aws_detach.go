@@ -0,0 +1,150 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	OceanDetachOceanId                       commons.FieldName = "ocean_id"
+	OceanDetachInstanceIds                   commons.FieldName = "instance_ids"
+	OceanDetachShouldDecrementTargetCapacity commons.FieldName = "should_decrement_target_capacity"
+	OceanDetachShouldTerminateInstances      commons.FieldName = "should_terminate_instances"
+	OceanDetachDetachedInstanceIds           commons.FieldName = "detached_instance_ids"
+)
+
+// resourceSpotinstOceanAWSDetach detaches instances from an Ocean cluster once,
+// when created. Every argument forces a new detach, and destroying the resource
+// only removes it from the state.
+func resourceSpotinstOceanAWSDetach() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSpotinstOceanAWSDetachCreate,
+		Read:   resourceSpotinstOceanAWSDetachRead,
+		Delete: resourceSpotinstOceanAWSDetachDelete,
+
+		Schema: map[string]*schema.Schema{
+			string(OceanDetachOceanId): {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			string(OceanDetachInstanceIds): {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				ForceNew: true,
+			},
+
+			string(OceanDetachShouldDecrementTargetCapacity): {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			string(OceanDetachShouldTerminateInstances): {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			string(OceanDetachDetachedInstanceIds): {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Create
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstOceanAWSDetachCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), string(commons.OceanAWSDetachResourceName))
+
+	oceanId := resourceData.Get(string(OceanDetachOceanId)).(string)
+	instanceIds := make([]string, 0)
+	for _, id := range resourceData.Get(string(OceanDetachInstanceIds)).(*schema.Set).List() {
+		instanceIds = append(instanceIds, id.(string))
+	}
+	sort.Strings(instanceIds)
+
+	svc := meta.(*Client).ocean.CloudProviderAWS()
+	resp, err := svc.ListClusterInstances(context.Background(), &aws.ListClusterInstancesInput{
+		ClusterID: spotinst.String(oceanId),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to list the instances of cluster %s: %s", oceanId, err)
+	}
+
+	members := make(map[string]bool)
+	for _, instance := range resp.Instances {
+		members[spotinst.StringValue(instance.ID)] = true
+	}
+	var foreign []string
+	for _, id := range instanceIds {
+		if !members[id] {
+			foreign = append(foreign, id)
+		}
+	}
+	if len(foreign) > 0 {
+		return fmt.Errorf("[ERROR] Instances %s do not belong to cluster %s", strings.Join(foreign, ", "), oceanId)
+	}
+
+	input := &aws.DetachClusterInstancesInput{
+		ClusterID:                     spotinst.String(oceanId),
+		InstanceIDs:                   instanceIds,
+		ShouldDecrementTargetCapacity: spotinst.Bool(resourceData.Get(string(OceanDetachShouldDecrementTargetCapacity)).(bool)),
+		ShouldTerminateInstances:      spotinst.Bool(resourceData.Get(string(OceanDetachShouldTerminateInstances)).(bool)),
+	}
+	if json, err := commons.ToJson(input); err != nil {
+		return err
+	} else {
+		log.Printf("===> Cluster detach configuration: %s", json)
+	}
+
+	if _, err := svc.DetachClusterInstances(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to detach instances from cluster %s: %s", oceanId, err)
+	}
+
+	if err := resourceData.Set(string(OceanDetachDetachedInstanceIds), instanceIds); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(OceanDetachDetachedInstanceIds), err)
+	}
+	resourceData.SetId(fmt.Sprintf("%s-%d", oceanId, hashcode.String(strings.Join(instanceIds, ","))))
+
+	log.Printf("===> Instances detached successfully from cluster %s: %s <===", oceanId, strings.Join(instanceIds, ", "))
+	return resourceSpotinstOceanAWSDetachRead(resourceData, meta)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Read
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstOceanAWSDetachRead(resourceData *schema.ResourceData, meta interface{}) error {
+	// A detach cannot be read back from the API, the state records the last one.
+	log.Printf(string(commons.ResourceOnRead), string(commons.OceanAWSDetachResourceName), resourceData.Id())
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Delete
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstOceanAWSDetachDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnDelete), string(commons.OceanAWSDetachResourceName), resourceData.Id())
+	resourceData.SetId("")
+	return nil
+}
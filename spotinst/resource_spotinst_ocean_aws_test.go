@@ -8,7 +8,6 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
-	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/ocean_aws_launch_configuration"
 	"log"
 	"testing"
 )
@@ -315,7 +314,7 @@ func TestAccSpotinstOceanAWS_LaunchConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0", "sg-042d658b3ee907848"),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "false"),
 					//resource.TestCheckResourceAttr(resourceName, "key_name", "my-key.ssh"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", ocean_aws_launch_configuration.HexStateFunc("echo hello world")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world")),
 					//resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "iam-profile"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.1116605596.key", "fakeKey"),
@@ -344,7 +343,7 @@ func TestAccSpotinstOceanAWS_LaunchConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0", "sg-042d658b3ee907848"),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "true"),
 					//resource.TestCheckResourceAttr(resourceName, "key_name", "my-key-updated.ssh"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", ocean_aws_launch_configuration.HexStateFunc("echo hello world updated")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world updated")),
 					//resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "iam-profile updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.3418058476.key", "fakeKeyUpdated"),
@@ -439,6 +438,147 @@ const testLaunchConfigAWSConfig_EmptyFields = `
 
 // endregion
 
+// region OceanAWS: Launch Specification
+func TestAccSpotinstOceanAWS_LaunchSpecification(t *testing.T) {
+	clusterName := "cluster-launch-specification"
+	controllerClusterID := "launch-spec-cluster-id"
+	resourceName := createOceanAWSResourceName(clusterName)
+
+	var cluster aws.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceName,
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+					launchConfig:        testLaunchSpecAWSConfig_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_size", "30"),
+					resource.TestCheckResourceAttr(resourceName, "enable_monitoring", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_put_response_hop_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tag_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tag_specification.0.should_tag_volumes", "true"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+					launchConfig:        testLaunchSpecAWSConfig_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "enable_monitoring", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_tokens", "optional"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tag_specification.0.should_tag_volumes", "false"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+					launchConfig:        testLaunchSpecAWSConfig_EmptyFields,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tag_specification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testLaunchSpecAWSConfig_Create = `
+ // --- LAUNCH SPECIFICATION --------------
+  image_id         = "ami-79826301"
+  security_groups  = ["sg-042d658b3ee907848"]
+  root_volume_size = 30
+  ebs_optimized    = true
+
+  ebs_block_device = [{
+    device_name           = "/dev/xvdb"
+    volume_type           = "gp2"
+    volume_size           = 20
+    encrypted             = true
+    delete_on_termination = true
+  }]
+
+  instance_metadata_options = {
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 1
+  }
+
+  resource_tag_specification = {
+    should_tag_volumes = true
+  }
+ // ---------------------------------------
+`
+
+const testLaunchSpecAWSConfig_Update = `
+ // --- LAUNCH SPECIFICATION --------------
+  image_id          = "ami-79826301"
+  security_groups   = ["sg-042d658b3ee907848"]
+  root_volume_size  = 50
+  enable_monitoring = true
+  ebs_optimized     = false
+
+  ebs_block_device = [{
+    device_name           = "/dev/xvdb"
+    volume_type           = "gp2"
+    volume_size           = 20
+    encrypted             = true
+    delete_on_termination = true
+  },
+  {
+    device_name           = "/dev/xvdc"
+    volume_type           = "gp2"
+    volume_size           = 40
+    delete_on_termination = true
+  }]
+
+  instance_metadata_options = {
+    http_tokens                 = "optional"
+    http_put_response_hop_limit = 2
+  }
+
+  resource_tag_specification = {
+    should_tag_volumes = false
+  }
+ // ---------------------------------------
+`
+
+const testLaunchSpecAWSConfig_EmptyFields = `
+ // --- LAUNCH SPECIFICATION --------------
+  image_id        = "ami-79826301"
+  security_groups = ["sg-042d658b3ee907848"]
+ // ---------------------------------------
+`
+
+// endregion
+
 // region OceanAWS: Strategy
 func TestAccSpotinstOceanAWS_Strategy(t *testing.T) {
 	clusterName := "cluster-strategy"
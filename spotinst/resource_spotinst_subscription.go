@@ -21,6 +21,8 @@ func resourceSpotinstSubscription() *schema.Resource {
 		Read:   resourceSpotinstSubscriptionRead,
 		Delete: resourceSpotinstSubscriptionDelete,
 
+		CustomizeDiff: validateSubscriptionEndpoint,
+
 		Schema: commons.SubscriptionResource.GetSchemaMap(),
 	}
 }
@@ -91,6 +93,12 @@ func resourceSpotinstSubscriptionCreate(resourceData *schema.ResourceData, meta
 		return err
 	}
 
+	if resourceData.Get(string(subscriptionPackage.VerifyOnCreate)).(bool) {
+		if err := verifySubscription(sub, eventSender); err != nil {
+			return err
+		}
+	}
+
 	subscriptionId, err := createSubscription(sub, meta.(*Client))
 	if err != nil {
 		return err
@@ -102,6 +110,22 @@ func resourceSpotinstSubscriptionCreate(resourceData *schema.ResourceData, meta
 	return resourceSpotinstSubscriptionRead(resourceData, meta)
 }
 
+// validateSubscriptionEndpoint checks the endpoint against its protocol. The
+// schema can only check each on its own, so the pair is checked on the diff
+// and a mismatch fails the plan.
+func validateSubscriptionEndpoint(diff *schema.ResourceDiff, meta interface{}) error {
+	protocol := diff.Get(string(subscriptionPackage.Protocol)).(string)
+	endpoint := diff.Get(string(subscriptionPackage.Endpoint)).(string)
+	if protocol == "" || endpoint == "" {
+		// Not known until apply.
+		return nil
+	}
+	if err := subscriptionPackage.ValidateEndpoint(protocol, endpoint); err != nil {
+		return fmt.Errorf("[ERROR] Invalid subscription endpoint: %s", err)
+	}
+	return nil
+}
+
 func createSubscription(subObj *subscription.Subscription, spotinstClient *Client) (*string, error) {
 	input := &subscription.CreateSubscriptionInput{Subscription: subObj}
 	resp, err := spotinstClient.subscription.Create(context.Background(), input)
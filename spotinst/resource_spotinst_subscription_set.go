@@ -0,0 +1,363 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/terraform-providers/terraform-provider-spotinst/spotinst/commons"
+
+	subscriptionPackage "github.com/terraform-providers/terraform-provider-spotinst/spotinst/subscription"
+)
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Variables
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+const (
+	SubscriptionSetResourceIds   commons.FieldName = "resource_ids"
+	SubscriptionSetEventTypes    commons.FieldName = "event_types"
+	SubscriptionSetSubscriptions commons.FieldName = "subscriptions"
+)
+
+// subscriptionSetSharedFields are the spotinst_subscription arguments every
+// subscription of a set shares.
+var subscriptionSetSharedFields = []commons.FieldName{
+	subscriptionPackage.Protocol,
+	subscriptionPackage.Endpoint,
+	subscriptionPackage.Format,
+	subscriptionPackage.EventFormat,
+}
+
+// resourceSpotinstSubscriptionSet manages a subscription for every pair of
+// resource_ids and event_types. The subscriptions are tracked by pair, so
+// changing either list only creates and deletes the pairs that changed, and
+// a pair whose subscription was deleted outside Terraform is planned again.
+func resourceSpotinstSubscriptionSet() *schema.Resource {
+	setupSubscription()
+
+	resourceSchema := map[string]*schema.Schema{
+		string(SubscriptionSetResourceIds): {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Required: true,
+		},
+
+		string(SubscriptionSetEventTypes): {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Required: true,
+		},
+
+		string(SubscriptionSetSubscriptions): {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+	for _, fieldName := range subscriptionSetSharedFields {
+		resourceSchema[string(fieldName)] = commons.SubscriptionResource.GetField(fieldName).GetSchema()
+	}
+
+	return &schema.Resource{
+		Create: resourceSpotinstSubscriptionSetCreate,
+		Update: resourceSpotinstSubscriptionSetUpdate,
+		Read:   resourceSpotinstSubscriptionSetRead,
+		Delete: resourceSpotinstSubscriptionSetDelete,
+
+		CustomizeDiff: resourceSpotinstSubscriptionSetCustomizeDiff,
+
+		Schema: resourceSchema,
+	}
+}
+
+// resourceSpotinstSubscriptionSetCustomizeDiff checks the endpoint against
+// its protocol and, when the tracked subscriptions no longer cover every
+// pair, marks them as changing so the plan runs an update to reconcile them.
+func resourceSpotinstSubscriptionSetCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateSubscriptionEndpoint(diff, meta); err != nil {
+		return err
+	}
+	if diff.Id() == "" {
+		return nil
+	}
+
+	tracked := diff.Get(string(SubscriptionSetSubscriptions)).(map[string]interface{})
+	desired := subscriptionSetPairs(
+		diff.Get(string(SubscriptionSetResourceIds)).(*schema.Set),
+		diff.Get(string(SubscriptionSetEventTypes)).(*schema.Set))
+	inSync := len(tracked) == len(desired)
+	for pair := range tracked {
+		if !desired[pair] {
+			inSync = false
+		}
+	}
+	if !inSync {
+		return diff.SetNewComputed(string(SubscriptionSetSubscriptions))
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Create
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstSubscriptionSetCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), string(commons.SubscriptionSetResourceName))
+
+	resourceData.SetId(resource.PrefixedUniqueId("subscription-set-"))
+	if err := reconcileSubscriptionSet(resourceData, meta.(*Client), false); err != nil {
+		return err
+	}
+
+	log.Printf("===> Subscription set created successfully: %s <===", resourceData.Id())
+	return resourceSpotinstSubscriptionSetRead(resourceData, meta)
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Read
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstSubscriptionSetRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), string(commons.SubscriptionSetResourceName), id)
+
+	existing, err := listSubscriptions(meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	tracked := subscriptionSetTracked(resourceData)
+	found := make(map[string]interface{}, len(tracked))
+	for pair, subscriptionId := range tracked {
+		sub, ok := existing[subscriptionId]
+		if !ok {
+			continue
+		}
+		found[pair] = subscriptionId
+
+		// Every subscription shares the protocol and endpoint, so any one
+		// that drifted is reported as the set drifting.
+		for fieldName, value := range map[commons.FieldName]*string{
+			subscriptionPackage.Protocol: sub.Protocol,
+			subscriptionPackage.Endpoint: sub.Endpoint,
+		} {
+			if resourceData.Get(string(fieldName)).(string) != spotinst.StringValue(value) {
+				if err := resourceData.Set(string(fieldName), spotinst.StringValue(value)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+				}
+			}
+		}
+	}
+
+	// If every subscription is gone, then return no state.
+	if len(tracked) > 0 && len(found) == 0 {
+		resourceData.SetId("")
+		return nil
+	}
+
+	// Subscriptions deleted outside Terraform are dropped from the state, so
+	// the next plan recreates the missing pairs.
+	if err := resourceData.Set(string(SubscriptionSetSubscriptions), found); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SubscriptionSetSubscriptions), err)
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Update
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstSubscriptionSetUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), string(commons.SubscriptionSetResourceName), id)
+
+	shouldUpdate := false
+	for _, fieldName := range subscriptionSetSharedFields {
+		if resourceData.HasChange(string(fieldName)) {
+			shouldUpdate = true
+		}
+	}
+	if err := reconcileSubscriptionSet(resourceData, meta.(*Client), shouldUpdate); err != nil {
+		return err
+	}
+
+	log.Printf("===> Subscription set updated successfully: %s <===", id)
+	return resourceSpotinstSubscriptionSetRead(resourceData, meta)
+}
+
+// reconcileSubscriptionSet creates the subscriptions of new pairs, deletes
+// those of removed pairs and, when shouldUpdate is set, updates the rest in
+// place. The tracked subscriptions are saved even when it fails part way, so
+// the next apply picks up where this one stopped.
+func reconcileSubscriptionSet(resourceData *schema.ResourceData, client *Client, shouldUpdate bool) (err error) {
+	// The plan marks the subscriptions as computed when they need reconciling,
+	// so start from the ones in the prior state.
+	previous, _ := resourceData.GetChange(string(SubscriptionSetSubscriptions))
+	tracked := previous.(map[string]interface{})
+	defer func() {
+		if setErr := resourceData.Set(string(SubscriptionSetSubscriptions), tracked); setErr != nil && err == nil {
+			err = fmt.Errorf(string(commons.FailureFieldReadPattern), string(SubscriptionSetSubscriptions), setErr)
+		}
+	}()
+
+	existing, err := listSubscriptions(client)
+	if err != nil {
+		return err
+	}
+	for pair, subscriptionId := range tracked {
+		if _, ok := existing[subscriptionId.(string)]; !ok {
+			delete(tracked, pair)
+		}
+	}
+
+	desired := subscriptionSetPairs(
+		resourceData.Get(string(SubscriptionSetResourceIds)).(*schema.Set),
+		resourceData.Get(string(SubscriptionSetEventTypes)).(*schema.Set))
+	var removed, added, kept []string
+	for pair := range tracked {
+		if !desired[pair] {
+			removed = append(removed, pair)
+		}
+	}
+	for pair := range desired {
+		if _, ok := tracked[pair]; ok {
+			kept = append(kept, pair)
+		} else {
+			added = append(added, pair)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	sort.Strings(kept)
+	log.Printf("===> Subscription set %s: %d to create, %d to delete, %d unchanged",
+		resourceData.Id(), len(added), len(removed), len(kept))
+
+	for _, pair := range removed {
+		subscriptionId := tracked[pair].(string)
+		input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(subscriptionId)}
+		if _, err := client.subscription.Delete(context.Background(), input); err != nil {
+			return fmt.Errorf("[ERROR] Failed to delete subscription %s for %s: %s", subscriptionId, pair, err)
+		}
+		delete(tracked, pair)
+	}
+
+	for _, pair := range added {
+		sub, err := expandSubscriptionSetPair(resourceData, pair)
+		if err != nil {
+			return err
+		}
+		subscriptionId, err := createSubscription(sub, client)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Failed to create subscription for %s: %s", pair, err)
+		}
+		tracked[pair] = spotinst.StringValue(subscriptionId)
+	}
+
+	if shouldUpdate {
+		for _, pair := range kept {
+			sub, err := expandSubscriptionSetPair(resourceData, pair)
+			if err != nil {
+				return err
+			}
+			if sub.Format == nil {
+				sub.SetFormat(nil)
+			}
+			sub.SetId(spotinst.String(tracked[pair].(string)))
+			input := &subscription.UpdateSubscriptionInput{Subscription: sub}
+			if _, err := client.subscription.Update(context.Background(), input); err != nil {
+				return fmt.Errorf("[ERROR] Failed to update subscription %s for %s: %s", tracked[pair], pair, err)
+			}
+		}
+	}
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Delete
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func resourceSpotinstSubscriptionSetDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), string(commons.SubscriptionSetResourceName), id)
+
+	client := meta.(*Client)
+	tracked := subscriptionSetTracked(resourceData)
+	pairs := make([]string, 0, len(tracked))
+	for pair := range tracked {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	for _, pair := range pairs {
+		input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(tracked[pair])}
+		if _, err := client.subscription.Delete(context.Background(), input); err != nil {
+			return fmt.Errorf("[ERROR] Failed to delete subscription %s for %s: %s", tracked[pair], pair, err)
+		}
+		delete(tracked, pair)
+		if err := resourceData.Set(string(SubscriptionSetSubscriptions), tracked); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SubscriptionSetSubscriptions), err)
+		}
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+//            Utils
+//-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+func listSubscriptions(client *Client) (map[string]*subscription.Subscription, error) {
+	resp, err := client.subscription.List(context.Background(), &subscription.ListSubscriptionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to list subscriptions: %s", err)
+	}
+
+	subscriptions := make(map[string]*subscription.Subscription, len(resp.Subscriptions))
+	for _, sub := range resp.Subscriptions {
+		subscriptions[spotinst.StringValue(sub.ID)] = sub
+	}
+	return subscriptions, nil
+}
+
+// subscriptionSetTracked returns the subscription IDs in the state, keyed by
+// "resource_id:EVENT_TYPE" pair.
+func subscriptionSetTracked(resourceData *schema.ResourceData) map[string]string {
+	tracked := make(map[string]string)
+	for pair, subscriptionId := range resourceData.Get(string(SubscriptionSetSubscriptions)).(map[string]interface{}) {
+		tracked[pair] = subscriptionId.(string)
+	}
+	return tracked
+}
+
+// subscriptionSetPairs returns the cross product of resource_ids and event_types.
+func subscriptionSetPairs(resourceIds, eventTypes *schema.Set) map[string]bool {
+	pairs := make(map[string]bool)
+	for _, resourceId := range resourceIds.List() {
+		for _, eventType := range eventTypes.List() {
+			pairs[resourceId.(string)+":"+strings.ToUpper(eventType.(string))] = true
+		}
+	}
+	return pairs
+}
+
+func expandSubscriptionSetPair(resourceData *schema.ResourceData, pair string) (*subscription.Subscription, error) {
+	i := strings.LastIndex(pair, ":")
+	sub := commons.NewSubscription()
+	sub.SetResourceId(spotinst.String(pair[:i]))
+	sub.SetEventType(spotinst.String(pair[i+1:]))
+	sub.SetProtocol(spotinst.String(resourceData.Get(string(subscriptionPackage.Protocol)).(string)))
+	sub.SetEndpoint(spotinst.String(resourceData.Get(string(subscriptionPackage.Endpoint)).(string)))
+
+	if v, ok := resourceData.GetOk(string(subscriptionPackage.Format)); ok {
+		sub.SetFormat(v.(map[string]interface{}))
+	}
+	if v, ok := resourceData.GetOk(string(subscriptionPackage.EventFormat)); ok {
+		format, err := subscriptionPackage.ExpandEventFormat(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		sub.SetFormat(format)
+	}
+	return sub, nil
+}
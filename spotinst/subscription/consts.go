@@ -12,4 +12,33 @@ const (
 	Protocol   commons.FieldName = "protocol"
 	Endpoint   commons.FieldName = "endpoint"
 	Format     commons.FieldName = "format"
+
+	EventFormat    commons.FieldName = "event_format"
+	VerifyOnCreate commons.FieldName = "verify_on_create"
+)
+
+const (
+	ProtocolHTTP      = "http"
+	ProtocolHTTPS     = "https"
+	ProtocolEmail     = "email"
+	ProtocolEmailJSON = "email-json"
+	ProtocolAWSSNS    = "aws-sns"
+	ProtocolWeb       = "web"
 )
+
+var protocols = []string{
+	ProtocolHTTP,
+	ProtocolHTTPS,
+	ProtocolEmail,
+	ProtocolEmailJSON,
+	ProtocolAWSSNS,
+	ProtocolWeb,
+}
+
+// formatPlaceholders are the values Spotinst substitutes in an event format.
+var formatPlaceholders = []string{
+	"%instance-id%",
+	"%event%",
+	"%resource-id%",
+	"%resource-name%",
+}
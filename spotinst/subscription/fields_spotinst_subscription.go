@@ -1,7 +1,12 @@
 package subscription
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -87,6 +92,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeString,
 			Required: true,
+			ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+				for _, protocol := range protocols {
+					if v.(string) == protocol {
+						return nil, nil
+					}
+				}
+				return nil, []error{fmt.Errorf("%q must be one of %s, got %q", k, strings.Join(protocols, ", "), v)}
+			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
@@ -118,6 +131,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeString,
 			Required: true,
+			ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+				// The protocol isn't known here, so accept anything some protocol would.
+				endpoint := v.(string)
+				for _, protocol := range protocols {
+					if ValidateEndpoint(protocol, endpoint) == nil {
+						return nil, nil
+					}
+				}
+				return nil, []error{fmt.Errorf("%q must be a URL, an email address or an SNS topic or SQS queue ARN, got %q", k, endpoint)}
+			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
@@ -147,10 +170,18 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		Format,
 		&schema.Schema{
-			Type:     schema.TypeMap,
-			Optional: true,
+			Type:          schema.TypeMap,
+			Optional:      true,
+			ConflictsWith: []string{string(EventFormat)},
+			ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+				return nil, validateFormatPlaceholders(k, v)
+			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// Formats declared through event_format may nest, which a map can't hold.
+			if _, ok := resourceData.GetOk(string(EventFormat)); ok {
+				return nil
+			}
 			sub := resourceObject.(*subscription.Subscription)
 			if err := resourceData.Set(string(Format), sub.Format); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Format), err)
@@ -159,13 +190,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
-			if v, ok := resourceData.Get(string(Format)).(map[string]interface{}); ok {
-				sub.SetFormat(v)
+			if v, ok := resourceData.GetOk(string(Format)); ok {
+				sub.SetFormat(v.(map[string]interface{}))
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
+			if _, ok := resourceData.GetOk(string(EventFormat)); ok {
+				return nil
+			}
 			if v, ok := resourceData.Get(string(Format)).(map[string]interface{}); ok {
 				sub.SetFormat(v)
 			}
@@ -173,8 +207,184 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[EventFormat] = commons.NewGenericField(
+		commons.Subscription,
+		EventFormat,
+		&schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{string(Format)},
+			StateFunc:     normalizeEventFormat,
+			ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+				var body map[string]interface{}
+				if err := json.Unmarshal([]byte(v.(string)), &body); err != nil {
+					return nil, []error{fmt.Errorf("%q must be a JSON object: %s", k, err)}
+				}
+				return nil, validateFormatPlaceholders(k, body)
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if _, ok := resourceData.GetOk(string(EventFormat)); !ok {
+				return nil
+			}
+			sub := resourceObject.(*subscription.Subscription)
+			value := ""
+			if sub.Format != nil {
+				if b, err := json.Marshal(sub.Format); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EventFormat), err)
+				} else {
+					value = string(b)
+				}
+			}
+			if err := resourceData.Set(string(EventFormat), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EventFormat), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			sub := resourceObject.(*subscription.Subscription)
+			if v, ok := resourceData.GetOk(string(EventFormat)); ok {
+				if format, err := ExpandEventFormat(v.(string)); err != nil {
+					return err
+				} else {
+					sub.SetFormat(format)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			sub := resourceObject.(*subscription.Subscription)
+			if v, ok := resourceData.GetOk(string(EventFormat)); ok {
+				if format, err := ExpandEventFormat(v.(string)); err != nil {
+					return err
+				} else {
+					sub.SetFormat(format)
+				}
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[VerifyOnCreate] = commons.NewGenericField(
+		commons.Subscription,
+		VerifyOnCreate,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
 }
 
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
 //            Utils
 //-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
+var (
+	placeholderPattern = regexp.MustCompile(`%[a-zA-Z0-9_-]+%`)
+	awsSNSARNPattern   = regexp.MustCompile(`^arn:aws[a-z-]*:(sns|sqs):[a-z0-9-]+:[0-9]{12}:[a-zA-Z0-9_-]{1,256}(\.fifo)?$`)
+)
+
+// ValidateEndpoint checks that endpoint is something protocol can deliver to:
+// a URL for http, https and web, an address for email and an SNS topic or
+// SQS queue ARN for aws-sns.
+func ValidateEndpoint(protocol, endpoint string) error {
+	switch protocol {
+	case ProtocolHTTP, ProtocolHTTPS, ProtocolWeb:
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return fmt.Errorf("%s endpoint must be a URL, got %q", protocol, endpoint)
+		}
+		if protocol == ProtocolWeb && (u.Scheme == ProtocolHTTP || u.Scheme == ProtocolHTTPS) {
+			return nil
+		}
+		if u.Scheme != protocol {
+			return fmt.Errorf("%s endpoint must be an %s:// URL, got %q", protocol, protocol, endpoint)
+		}
+	case ProtocolEmail, ProtocolEmailJSON:
+		if addr, err := mail.ParseAddress(endpoint); err != nil || addr.Address != endpoint {
+			return fmt.Errorf("%s endpoint must be an email address, got %q", protocol, endpoint)
+		}
+	case ProtocolAWSSNS:
+		if !awsSNSARNPattern.MatchString(endpoint) {
+			return fmt.Errorf("%s endpoint must be an SNS topic or SQS queue ARN, got %q", protocol, endpoint)
+		}
+	default:
+		return fmt.Errorf("unsupported protocol %q", protocol)
+	}
+	return nil
+}
+
+// validateFormatPlaceholders walks a format, nested objects and arrays
+// included, and reports every placeholder Spotinst would not substitute.
+func validateFormatPlaceholders(k string, format interface{}) []error {
+	known := make(map[string]bool, len(formatPlaceholders))
+	for _, p := range formatPlaceholders {
+		known[p] = true
+	}
+
+	unknown := make(map[string]bool)
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch value := v.(type) {
+		case string:
+			for _, p := range placeholderPattern.FindAllString(value, -1) {
+				if !known[p] {
+					unknown[p] = true
+				}
+			}
+		case map[string]interface{}:
+			for key, item := range value {
+				walk(key)
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range value {
+				walk(item)
+			}
+		}
+	}
+	walk(format)
+
+	if len(unknown) == 0 {
+		return nil
+	}
+	invalid := make([]string, 0, len(unknown))
+	for p := range unknown {
+		invalid = append(invalid, p)
+	}
+	sort.Strings(invalid)
+	return []error{fmt.Errorf("%q has unknown placeholders %s, valid placeholders are %s",
+		k, strings.Join(invalid, ", "), strings.Join(formatPlaceholders, ", "))}
+}
+
+// ExpandEventFormat parses an event_format into the format sent to the API.
+func ExpandEventFormat(data string) (map[string]interface{}, error) {
+	var format map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &format); err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to parse %s: %s", EventFormat, err)
+	}
+	return format, nil
+}
+
+// normalizeEventFormat compacts the JSON and sorts its keys, the way the
+// format is read back from the API.
+func normalizeEventFormat(v interface{}) string {
+	format, err := ExpandEventFormat(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	b, _ := json.Marshal(format)
+	return string(b)
+}
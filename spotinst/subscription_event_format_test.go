@@ -0,0 +1,110 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testSubscriptionEventFormat = `{
+  "text": "Instance %instance-id% launched in %resource-name%",
+  "attachments": [{"fields": [{"title": "Group", "value": "%resource-id%"}]}]
+}`
+
+func TestSubscriptionEventFormatValidation(t *testing.T) {
+	cases := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"nested template": {
+			raw: map[string]interface{}{"event_format": testSubscriptionEventFormat},
+		},
+		"malformed JSON": {
+			raw: map[string]interface{}{"event_format": `{"text": "%event%"`},
+			err: "must be a JSON object",
+		},
+		"unknown nested placeholder": {
+			raw: map[string]interface{}{"event_format": `{"blocks": [{"text": "%instance_id% %event%"}]}`},
+			err: "unknown placeholders %instance_id%",
+		},
+		"unknown format placeholder": {
+			raw: map[string]interface{}{"format": map[string]interface{}{"id": "%group-id%"}},
+			err: "unknown placeholders %group-id%",
+		},
+		"both formats": {
+			raw: map[string]interface{}{
+				"format":       map[string]interface{}{"id": "%resource-id%"},
+				"event_format": `{"id": "%resource-id%"}`,
+			},
+			err: "conflicts with",
+		},
+	}
+
+	for name, c := range cases {
+		c.raw["resource_id"] = "sig-12345678"
+		c.raw["event_type"] = "AWS_EC2_INSTANCE_LAUNCH"
+		c.raw["protocol"] = "web"
+		c.raw["endpoint"] = "https://hooks.slack.com/services/T0/B0/X"
+
+		rawConfig, err := config.NewRawConfig(c.raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_, errs := resourceSpotinstSubscription().Validate(terraform.NewResourceConfig(rawConfig))
+		if c.err == "" {
+			if len(errs) > 0 {
+				t.Fatalf("%s: unexpected errors: %v", name, errs)
+			}
+			continue
+		}
+		if len(errs) == 0 || !strings.Contains(errs[0].Error(), c.err) {
+			t.Fatalf("%s: expected an error containing %q, got %v", name, c.err, errs)
+		}
+	}
+}
+
+func TestSubscriptionEventFormatCreate(t *testing.T) {
+	const subscription = `{
+  "id": "sis-12345678",
+  "resourceId": "sig-12345678",
+  "eventType": "AWS_EC2_INSTANCE_LAUNCH",
+  "protocol": "web",
+  "endpoint": "https://hooks.slack.com/services/T0/B0/X",
+  "eventFormat": {"attachments": [{"fields": [{"title": "Group", "value": "%resource-id%"}]}], "text": "Instance %instance-id% launched in %resource-name%"}
+}`
+
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("POST", "/events/subscription", subscription)
+	api.Handle("GET", "/events/subscription/sis-12345678", subscription)
+
+	res := resourceSpotinstSubscription()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"resource_id":  "sig-12345678",
+		"event_type":   "AWS_EC2_INSTANCE_LAUNCH",
+		"protocol":     "web",
+		"endpoint":     "https://hooks.slack.com/services/T0/B0/X",
+		"event_format": testSubscriptionEventFormat,
+	})
+
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := api.RequestBody("POST", "/events/subscription")
+	expected := `"eventFormat":{"attachments":[{"fields":[{"title":"Group","value":"%resource-id%"}]}],"text":"Instance %instance-id% launched in %resource-name%"}`
+	if !strings.Contains(body, expected) {
+		t.Fatalf("expected request body to contain %s, got %s", expected, body)
+	}
+
+	normalized := res.Schema["event_format"].StateFunc(testSubscriptionEventFormat)
+	if got := resourceData.Get("event_format").(string); got != normalized {
+		t.Fatalf("expected event_format to read back as %s, got %s", normalized, got)
+	}
+	if got := resourceData.Get("format").(map[string]interface{}); len(got) != 0 {
+		t.Fatalf("expected format to stay empty, got %v", got)
+	}
+}
@@ -0,0 +1,107 @@
+package spotinst
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	subscriptionPackage "github.com/terraform-providers/terraform-provider-spotinst/spotinst/subscription"
+)
+
+// EventSender delivers a synthetic event to a subscription endpoint, so that
+// verify_on_create can check the endpoint before the subscription exists.
+type EventSender interface {
+	Send(protocol, endpoint string, body []byte) error
+}
+
+// eventSender delivers the events sent by verify_on_create.
+var eventSender EventSender = &httpEventSender{client: &http.Client{Timeout: 30 * time.Second}}
+
+// httpEventSender posts events to http, https and web endpoints. It cannot
+// reach email addresses or SNS topics.
+type httpEventSender struct {
+	client *http.Client
+}
+
+func (s *httpEventSender) Send(protocol, endpoint string, body []byte) error {
+	switch protocol {
+	case subscriptionPackage.ProtocolHTTP, subscriptionPackage.ProtocolHTTPS, subscriptionPackage.ProtocolWeb:
+	default:
+		return fmt.Errorf("test delivery is not supported for the %s protocol", protocol)
+	}
+
+	resp, err := s.client.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// verifySubscription sends a synthetic event to the subscription endpoint,
+// shaped by its format with every placeholder filled in.
+func verifySubscription(sub *subscription.Subscription, sender EventSender) error {
+	values := map[string]string{
+		"%instance-id%":   "i-00000000000000000",
+		"%event%":         spotinst.StringValue(sub.EventType),
+		"%resource-id%":   spotinst.StringValue(sub.ResourceID),
+		"%resource-name%": "terraform-verification",
+	}
+
+	format := sub.Format
+	if len(format) == 0 {
+		format = map[string]interface{}{
+			"event":         "%event%",
+			"instance_id":   "%instance-id%",
+			"resource_id":   "%resource-id%",
+			"resource_name": "%resource-name%",
+		}
+	}
+
+	body, err := json.Marshal(fillPlaceholders(format, values))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to build verification event: %s", err)
+	}
+
+	protocol, endpoint := spotinst.StringValue(sub.Protocol), spotinst.StringValue(sub.Endpoint)
+	if err := sender.Send(protocol, endpoint, body); err != nil {
+		return fmt.Errorf("[ERROR] Failed to verify subscription endpoint %s: %s", endpoint, err)
+	}
+	return nil
+}
+
+func fillPlaceholders(v interface{}, values map[string]string) interface{} {
+	switch value := v.(type) {
+	case string:
+		for placeholder, replacement := range values {
+			value = strings.Replace(value, placeholder, replacement, -1)
+		}
+		return value
+	case map[string]interface{}:
+		filled := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			filled[fillPlaceholders(key, values).(string)] = fillPlaceholders(item, values)
+		}
+		return filled
+	case []interface{}:
+		filled := make([]interface{}, len(value))
+		for i, item := range value {
+			filled[i] = fillPlaceholders(item, values)
+		}
+		return filled
+	}
+	return v
+}
@@ -0,0 +1,143 @@
+package spotinst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSubscriptionSetReconcile(t *testing.T) {
+	api := newTestCollectionAPI("/events/subscription", "subscription", "sis-")
+	defer api.Close()
+	client := api.Client(t)
+
+	res := resourceSpotinstSubscriptionSet()
+	plan := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceDiff {
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		diff, err := res.Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return diff
+	}
+	apply := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+		state, err := res.Apply(state, plan(state, raw), client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return state
+	}
+	expectRequests := func(step string, expected ...string) {
+		if got := api.Requests(); strings.Join(got, ",") != strings.Join(expected, ",") {
+			t.Fatalf("%s: expected requests %v, got %v", step, expected, got)
+		}
+	}
+	raw := func(resourceIds []interface{}, endpoint string) map[string]interface{} {
+		return map[string]interface{}{
+			"resource_ids": resourceIds,
+			"event_types":  []interface{}{"AWS_EC2_INSTANCE_LAUNCH", "group_roll_failed"},
+			"protocol":     "https",
+			"endpoint":     endpoint,
+		}
+	}
+
+	state := apply(nil, raw([]interface{}{"sig-1", "sig-2"}, "https://oncall.example.com"))
+	expectRequests("create", "POST", "POST", "POST", "POST")
+	if state.Attributes["subscriptions.%"] != "4" {
+		t.Fatalf("expected 4 subscriptions, got %v", state.Attributes)
+	}
+	unaffected := state.Attributes["subscriptions.sig-2:GROUP_ROLL_FAILED"]
+
+	state = apply(state, raw([]interface{}{"sig-2", "sig-3"}, "https://oncall.example.com"))
+	expectRequests("swap a resource", "DELETE", "DELETE", "POST", "POST")
+	if got := state.Attributes["subscriptions.sig-2:GROUP_ROLL_FAILED"]; got != unaffected {
+		t.Fatalf("expected the sig-2 subscription to be kept as %s, got %s", unaffected, got)
+	}
+	if _, ok := state.Attributes["subscriptions.sig-1:AWS_EC2_INSTANCE_LAUNCH"]; ok {
+		t.Fatalf("expected the sig-1 subscriptions to be removed, got %v", state.Attributes)
+	}
+
+	state = apply(state, raw([]interface{}{"sig-2", "sig-3"}, "https://pager.example.com"))
+	expectRequests("change the endpoint", "PUT", "PUT", "PUT", "PUT")
+	if got := api.Object(unaffected)["endpoint"]; got != "https://pager.example.com" {
+		t.Fatalf("expected the endpoint to be updated in place, got %v", got)
+	}
+
+	if diff := plan(state, raw([]interface{}{"sig-2", "sig-3"}, "https://pager.example.com")); !diff.Empty() {
+		t.Fatalf("expected no changes once applied, got %v", diff)
+	}
+
+	// A subscription deleted outside Terraform is planned again, and only it
+	// is recreated.
+	api.Remove(state.Attributes["subscriptions.sig-3:AWS_EC2_INSTANCE_LAUNCH"])
+	state, err := res.Refresh(state, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.Attributes["subscriptions.%"] != "3" || state.Attributes["resource_ids.#"] != "2" {
+		t.Fatalf("expected the refresh to drop only the deleted subscription, got %v", state.Attributes)
+	}
+	diff := plan(state, raw([]interface{}{"sig-2", "sig-3"}, "https://pager.example.com"))
+	if diff.Empty() || !diff.Attributes["subscriptions.%"].NewComputed {
+		t.Fatalf("expected the plan to reconcile the subscriptions, got %v", diff)
+	}
+	state = apply(state, raw([]interface{}{"sig-2", "sig-3"}, "https://pager.example.com"))
+	expectRequests("recreate a deleted subscription", "POST")
+	if state.Attributes["subscriptions.%"] != "4" || state.Attributes["resource_ids.#"] != "2" {
+		t.Fatalf("expected 4 subscriptions over 2 resources, got %v", state.Attributes)
+	}
+
+	resourceData := res.Data(state)
+	if err := res.Delete(resourceData, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectRequests("destroy", "DELETE", "DELETE", "DELETE", "DELETE")
+	if objects := api.Objects(); len(objects) != 0 {
+		t.Fatalf("expected every subscription to be deleted, got %v", objects)
+	}
+}
+
+func TestSubscriptionSetPartialCreate(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("GET", "/events/subscription")
+
+	res := resourceSpotinstSubscriptionSet()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"resource_ids": []interface{}{"sig-1"},
+		"event_types":  []interface{}{"AWS_EC2_INSTANCE_LAUNCH"},
+		"protocol":     "https",
+		"endpoint":     "https://oncall.example.com",
+	})
+
+	err := res.Create(resourceData, api.Client(t))
+	if err == nil || !strings.Contains(err.Error(), "Failed to create subscription for sig-1:AWS_EC2_INSTANCE_LAUNCH") {
+		t.Fatalf("expected a create error, got %v", err)
+	}
+	if resourceData.Id() == "" {
+		t.Fatalf("expected the set to keep its ID so the next apply can resume")
+	}
+}
+
+func TestSubscriptionSetEndpointValidation(t *testing.T) {
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"resource_ids": []interface{}{"sig-1"},
+		"event_types":  []interface{}{"AWS_EC2_INSTANCE_LAUNCH"},
+		"protocol":     "https",
+		"endpoint":     "oncall@example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = resourceSpotinstSubscriptionSet().Diff(nil, terraform.NewResourceConfig(rawConfig), nil)
+	if err == nil || !strings.Contains(err.Error(), "https endpoint must be a URL") {
+		t.Fatalf("expected the plan to fail, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package spotinst
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSubscriptionEndpointValidation(t *testing.T) {
+	cases := map[string]struct {
+		protocol string
+		endpoint string
+		err      string
+	}{
+		"https url":           {protocol: "https", endpoint: "https://events.example.com/hook"},
+		"web url":             {protocol: "web", endpoint: "http://events.example.com/hook"},
+		"email":               {protocol: "email", endpoint: "oncall@example.com"},
+		"sns topic":           {protocol: "aws-sns", endpoint: "arn:aws:sns:us-east-1:123456789012:oncall"},
+		"unknown protocol":    {protocol: "sms", endpoint: "https://events.example.com", err: "must be one of"},
+		"sqs queue":           {protocol: "aws-sns", endpoint: "arn:aws:sqs:us-east-1:123456789012:oncall"},
+		"s3 bucket":           {protocol: "aws-sns", endpoint: "arn:aws:s3:::oncall", err: "must be a URL, an email address or an SNS topic or SQS queue ARN"},
+		"http with email":     {protocol: "http", endpoint: "oncall@example.com", err: "http endpoint must be a URL"},
+		"https with http url": {protocol: "https", endpoint: "http://events.example.com", err: "https endpoint must be an https:// URL"},
+		"email with url":      {protocol: "email-json", endpoint: "https://events.example.com", err: "email-json endpoint must be an email address"},
+		"sns with url":        {protocol: "aws-sns", endpoint: "https://events.example.com", err: "aws-sns endpoint must be an SNS topic or SQS queue ARN"},
+	}
+
+	for name, c := range cases {
+		raw := map[string]interface{}{
+			"resource_id": "sig-12345678",
+			"event_type":  "AWS_EC2_INSTANCE_LAUNCH",
+			"protocol":    c.protocol,
+			"endpoint":    c.endpoint,
+		}
+
+		// Mismatched pairs pass the schema and fail the plan.
+		res := resourceSpotinstSubscription()
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_, errs := res.Validate(terraform.NewResourceConfig(rawConfig))
+		if len(errs) == 0 {
+			if _, err := res.Diff(nil, terraform.NewResourceConfig(rawConfig), nil); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if c.err == "" {
+			if len(errs) > 0 {
+				t.Fatalf("%s: unexpected errors: %v", name, errs)
+			}
+			continue
+		}
+		if len(errs) == 0 || !strings.Contains(errs[0].Error(), c.err) {
+			t.Fatalf("%s: expected an error containing %q, got %v", name, c.err, errs)
+		}
+	}
+}
+
+const testSubscription = `{
+  "id": "sis-12345678",
+  "resourceId": "sig-12345678",
+  "eventType": "AWS_EC2_INSTANCE_LAUNCH",
+  "protocol": "web",
+  "endpoint": "https://events.example.com/hook"
+}`
+
+func TestSubscriptionVerifyOnCreate(t *testing.T) {
+	cases := map[string]struct {
+		status  int
+		err     string
+		created bool
+	}{
+		"delivered":     {status: http.StatusOK, created: true},
+		"not delivered": {status: http.StatusNotFound, err: "endpoint responded with 404 Not Found"},
+	}
+
+	for name, c := range cases {
+		var received string
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			received = string(body)
+			w.WriteHeader(c.status)
+		}))
+
+		api := newTestOfflineAPI(t)
+		api.Handle("POST", "/events/subscription", testSubscription)
+		api.Handle("GET", "/events/subscription/sis-12345678", testSubscription)
+
+		res := resourceSpotinstSubscription()
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"resource_id":      "sig-12345678",
+			"event_type":       "AWS_EC2_INSTANCE_LAUNCH",
+			"protocol":         "web",
+			"endpoint":         endpoint.URL,
+			"event_format":     `{"text": "%event% on %resource-id%", "tags": ["%instance-id%"]}`,
+			"verify_on_create": true,
+		})
+
+		err := res.Create(resourceData, api.Client(t))
+		requests := strings.Join(api.Requests(), ",")
+		api.Close()
+		endpoint.Close()
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("%s: expected error containing %q, got %v", name, c.err, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if created := strings.Contains(requests, "POST /events/subscription"); created != c.created {
+			t.Fatalf("%s: expected created to be %v, requests were %s", name, c.created, requests)
+		}
+
+		expected := `{"tags":["i-00000000000000000"],"text":"AWS_EC2_INSTANCE_LAUNCH on sig-12345678"}`
+		if received != expected {
+			t.Fatalf("%s: expected the endpoint to receive %s, got %s", name, expected, received)
+		}
+	}
+}
+
+type testEventSender struct {
+	protocol, endpoint string
+}
+
+func (s *testEventSender) Send(protocol, endpoint string, body []byte) error {
+	s.protocol, s.endpoint = protocol, endpoint
+	return nil
+}
+
+func TestSubscriptionVerifyOnCreateSender(t *testing.T) {
+	api := newTestOfflineAPI(t)
+	defer api.Close()
+	api.Handle("POST", "/events/subscription", testSubscription)
+	api.Handle("GET", "/events/subscription/sis-12345678", testSubscription)
+
+	sender := &testEventSender{}
+	defaultSender := eventSender
+	eventSender = sender
+	defer func() { eventSender = defaultSender }()
+
+	res := resourceSpotinstSubscription()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"resource_id":      "sig-12345678",
+		"event_type":       "AWS_EC2_INSTANCE_LAUNCH",
+		"protocol":         "aws-sns",
+		"endpoint":         "arn:aws:sns:us-east-1:123456789012:oncall",
+		"verify_on_create": true,
+	})
+
+	if err := res.Create(resourceData, api.Client(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sender.protocol != "aws-sns" || sender.endpoint != "arn:aws:sns:us-east-1:123456789012:oncall" {
+		t.Fatalf("expected the event to go through the configured sender, got %+v", sender)
+	}
+}